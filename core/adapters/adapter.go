@@ -77,6 +77,19 @@ func (p PipelineAdapter) MinPayment() *assets.Link {
 	return p.minPayment
 }
 
+// OffChainTasks returns the leading tasks of a pipeline, up to the first task
+// encoding its result for or sending it in an ethereum transaction.
+func OffChainTasks(tasks []models.TaskSpec) []models.TaskSpec {
+	for i, task := range tasks {
+		switch task.Type {
+		case TaskTypeEthBool, TaskTypeEthBytes32, TaskTypeEthInt256, TaskTypeEthUint256,
+			TaskTypeEthTx, TaskTypeEthTxABIEncode:
+			return tasks[:i]
+		}
+	}
+	return tasks
+}
+
 // For determines the adapter type to use for a given task.
 func For(task models.TaskSpec, config orm.ConfigReader, orm *orm.ORM) (*PipelineAdapter, error) {
 	var ba BaseAdapter
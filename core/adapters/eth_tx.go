@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -23,7 +24,11 @@ const (
 )
 
 // EthTx holds the Address to send the result to and the FunctionSelector
-// to execute.
+// to execute. ChainID selects the chain the transaction is sent on, defaulting
+// to the node's default chain. GasEstimator names the estimator pricing the
+// transaction when GasPrice is not set, overriding the chain's default gas
+// price. FromAddress binds the task to one of the node's keys, which then
+// sends all of its transactions instead of the next key in round robin.
 type EthTx struct {
 	Address          common.Address       `json:"address"`
 	FunctionSelector eth.FunctionSelector `json:"functionSelector"`
@@ -31,6 +36,9 @@ type EthTx struct {
 	DataFormat       string               `json:"format"`
 	GasPrice         *utils.Big           `json:"gasPrice" gorm:"type:numeric"`
 	GasLimit         uint64               `json:"gasLimit"`
+	ChainID          *utils.Big           `json:"chainId"`
+	GasEstimator     string               `json:"gasEstimator"`
+	FromAddress      *common.Address      `json:"fromAddress,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -42,12 +50,20 @@ func (e *EthTx) TaskType() models.TaskType {
 // is not currently pending. Then it confirms the transaction was confirmed on
 // the blockchain.
 func (e *EthTx) Perform(input models.RunInput, store *strpkg.Store) models.RunOutput {
-	if !store.TxManager.Connected() {
+	chain, err := store.Chain(e.ChainID.ToInt())
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	if !chain.TxManager.Connected() {
 		return pendingOutgoingConfirmationsOrConnection(input)
 	}
 
 	if input.Status().PendingOutgoingConfirmations() {
-		return ensureTxRunResult(input, store)
+		if awaitingBatch(input) {
+			return attachBatchedTx(input, store)
+		}
+		return ensureTxRunResult(input, chain.TxManager)
 	}
 
 	value, err := getTxData(e, input)
@@ -57,7 +73,40 @@ func (e *EthTx) Perform(input models.RunInput, store *strpkg.Store) models.RunOu
 	}
 
 	data := utils.ConcatBytes(e.FunctionSelector.Bytes(), e.DataPrefix, value)
-	return createTxRunResult(e.Address, e.GasPrice, e.GasLimit, data, input, store)
+	if e.FromAddress == nil && isOracleFulfillment(e.FunctionSelector) && chain.TxManager.BatchingEnabled() {
+		return createBatchedTxRunResult(e.Address, e.GasLimit, data, input, chain.TxManager)
+	}
+	return createTxRunResult(e.FromAddress, e.Address, e.gasPrice(store, chain), e.GasLimit, data, input, chain.TxManager)
+}
+
+// gasPrice returns the gas price set on the task in dev mode, or else the one
+// picked by the task's gas estimator, which is bounded by the chain's
+// ETH_MAX_GAS_PRICE_WEI. If neither is available, nil is returned and the
+// transaction is sent at the chain's default gas price.
+func (e *EthTx) gasPrice(store *strpkg.Store, chain strpkg.Chain) *utils.Big {
+	if e.GasPrice != nil && store.Config.Dev() {
+		return e.GasPrice
+	}
+	if e.GasEstimator == "" {
+		return nil
+	}
+	estimator, err := store.GasEstimator(chain.ID, e.GasEstimator)
+	if err != nil {
+		logger.Warnw("Unable to use gas estimator, falling back to the default gas price", "estimator", e.GasEstimator, "error", err)
+		return nil
+	}
+	gasPrice, err := estimator.GasPrice()
+	if err != nil {
+		logger.Warnw("Unable to estimate gas price, falling back to the default gas price", "estimator", e.GasEstimator, "error", err)
+		return nil
+	}
+	return utils.NewBig(gasPrice)
+}
+
+// isOracleFulfillment returns true if the function selector is that of
+// Oracle.fulfillOracleRequest, the only calls eligible for batching.
+func isOracleFulfillment(selector eth.FunctionSelector) bool {
+	return selector.String() == models.OracleFulfillmentFunctionID20190128withoutCast
 }
 
 // getTxData returns the data to save against the callback encoded according to
@@ -80,21 +129,18 @@ func getTxData(e *EthTx, input models.RunInput) ([]byte, error) {
 }
 
 func createTxRunResult(
+	from *common.Address,
 	address common.Address,
 	gasPrice *utils.Big,
 	gasLimit uint64,
 	data []byte,
 	input models.RunInput,
-	store *strpkg.Store,
+	txManager strpkg.TxManager,
 ) models.RunOutput {
-	tx, err := store.TxManager.CreateTxWithGas(
-		null.StringFrom(input.JobRunID().String()),
-		address,
-		data,
-		gasPrice.ToInt(),
-		gasLimit,
-	)
-	if err != nil {
+	tx, err := createTx(from, address, gasPrice, gasLimit, data, input, txManager)
+	if errors.Cause(err) == strpkg.ErrTxWouldRevert {
+		return models.NewRunOutputError(err)
+	} else if err != nil {
 		return models.NewRunOutputPendingOutgoingConfirmationsWithData(input.Data())
 	}
 
@@ -104,7 +150,7 @@ func createTxRunResult(
 	}
 
 	txAttempt := tx.Attempts[0]
-	receipt, state, err := store.TxManager.CheckAttempt(txAttempt, tx.SentAt)
+	receipt, state, err := txManager.CheckAttempt(txAttempt, tx.SentAt)
 	if err != nil {
 		return models.NewRunOutputPendingOutgoingConfirmationsWithData(output)
 	}
@@ -130,14 +176,96 @@ func createTxRunResult(
 	return models.NewRunOutputPendingOutgoingConfirmationsWithData(output)
 }
 
-func ensureTxRunResult(input models.RunInput, str *strpkg.Store) models.RunOutput {
+// createTx sends the transaction from the given account if any, and from the
+// next account in round robin otherwise.
+func createTx(
+	from *common.Address,
+	address common.Address,
+	gasPrice *utils.Big,
+	gasLimit uint64,
+	data []byte,
+	input models.RunInput,
+	txManager strpkg.TxManager,
+) (*models.Tx, error) {
+	surrogateID := null.StringFrom(input.JobRunID().String())
+	if from != nil {
+		return txManager.CreateTxWithGasFrom(surrogateID, *from, address, data, gasPrice.ToInt(), gasLimit)
+	}
+	return txManager.CreateTxWithGas(surrogateID, address, data, gasPrice.ToInt(), gasLimit)
+}
+
+// createBatchedTxRunResult queues the call to be bundled with the other
+// fulfillments sent to the same oracle contract within the batch window. The
+// index of the call within the batch is kept in the output until the batch is
+// sent, see attachBatchedTx.
+func createBatchedTxRunResult(
+	address common.Address,
+	gasLimit uint64,
+	data []byte,
+	input models.RunInput,
+	txManager strpkg.TxManager,
+) models.RunOutput {
+	index, err := txManager.CreateBatchedTx(input.JobRunID(), address, data, gasLimit)
+	if err != nil {
+		return models.NewRunOutputPendingOutgoingConfirmationsWithData(input.Data())
+	}
+
+	output, err := models.JSON{}.Add("batchIndex", index)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputPendingOutgoingConfirmationsWithData(output)
+}
+
+// awaitingBatch returns true if the call of the run was queued for a batch
+// whose transaction is not known yet.
+func awaitingBatch(input models.RunInput) bool {
+	return input.Data().Get("batchIndex").Exists() &&
+		!input.Data().Get("batchForwarder").Exists()
+}
+
+// attachBatchedTx adds the hash of the batched transaction holding the call
+// of the run to its output once the batch is sent, so that its confirmations
+// are then tracked like those of any other transaction. The forwarder is kept
+// in the output to check the outcome of the call once the transaction is
+// confirmed.
+func attachBatchedTx(input models.RunInput, store *strpkg.Store) models.RunOutput {
+	call, err := store.FindTxBatchCall(input.JobRunID())
+	if errors.Cause(err) == orm.ErrorNotFound {
+		return models.NewRunOutputError(errors.New("batched call of the run was not recorded"))
+	} else if err != nil {
+		logger.Warnw("Unable to find batched call of run", "jobRunID", input.JobRunID(), "error", err)
+		return models.NewRunOutputPendingOutgoingConfirmationsWithData(input.Data())
+	} else if call.Error.Valid {
+		return models.NewRunOutputError(fmt.Errorf("batched transaction was not sent: %s", call.Error.String))
+	} else if !call.Sent() {
+		return models.NewRunOutputPendingOutgoingConfirmationsWithData(input.Data())
+	}
+
+	tx, err := store.FindTx(*call.TxID)
+	if err != nil {
+		logger.Warnw("Unable to find batched transaction of run", "jobRunID", input.JobRunID(), "txID", *call.TxID, "error", err)
+		return models.NewRunOutputPendingOutgoingConfirmationsWithData(input.Data())
+	}
+	output, err := models.JSON{}.MultiAdd(models.KV{
+		"result":         tx.Hash.String(),
+		"batchForwarder": tx.To.Hex(),
+		"batchIndex":     call.CallIndex,
+	})
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputPendingOutgoingConfirmationsWithData(output)
+}
+
+func ensureTxRunResult(input models.RunInput, txManager strpkg.TxManager) models.RunOutput {
 	val, err := input.ResultString()
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
 
 	hash := common.HexToHash(val)
-	receipt, state, err := str.TxManager.BumpGasUntilSafe(hash)
+	receipt, state, err := txManager.BumpGasUntilSafe(hash)
 	if err != nil {
 		// We failed to get one of the TxAttempt receipts, so we won't mark this
 		// run as errored in order to try again
@@ -146,6 +274,18 @@ func ensureTxRunResult(input models.RunInput, str *strpkg.Store) models.RunOutpu
 
 	var output models.JSON
 
+	batchForwarder := input.Data().Get("batchForwarder")
+	batchIndex := input.Data().Get("batchIndex")
+	if batchIndex.Exists() {
+		output, err = output.MultiAdd(models.KV{
+			"batchForwarder": batchForwarder.String(),
+			"batchIndex":     batchIndex.Int(),
+		})
+		if err != nil {
+			return models.NewRunOutputError(err)
+		}
+	}
+
 	if receipt != nil && !receipt.Unconfirmed() {
 		// If the tx has been confirmed, record the hash in the output
 		hex := receipt.Hash.String()
@@ -174,12 +314,32 @@ func ensureTxRunResult(input models.RunInput, str *strpkg.Store) models.RunOutpu
 			return models.NewRunOutputError(err)
 		}
 
+		if batchIndex.Exists() {
+			forwarder := common.HexToAddress(batchForwarder.String())
+			if err := checkBatchedCall(*receipt, forwarder, int(batchIndex.Int())); err != nil {
+				return models.NewRunOutputError(err)
+			}
+		}
+
 		return addReceiptToResult(*receipt, input, output)
 	}
 
 	return models.NewRunOutputPendingOutgoingConfirmationsWithData(output)
 }
 
+// checkBatchedCall returns an error if the call at the given index of a
+// batched transaction reverted, even though the transaction itself succeeded.
+func checkBatchedCall(receipt eth.TxReceipt, forwarder common.Address, index int) error {
+	succeeded, err := eth.MulticallCallSucceeded(receipt, forwarder, index)
+	if err != nil {
+		return err
+	}
+	if !succeeded {
+		return fmt.Errorf("call #%d of batched transaction %s reverted", index, receipt.Hash.Hex())
+	}
+	return nil
+}
+
 func addReceiptToResult(
 	receipt eth.TxReceipt,
 	input models.RunInput,
@@ -29,6 +29,12 @@ type EthTxABIEncode struct {
 	FunctionABI abi.Method `json:"functionABI"`
 	GasPrice    *utils.Big `json:"gasPrice" gorm:"type:numeric"`
 	GasLimit    uint64     `json:"gasLimit"`
+	// ID of the chain the transaction is sent on, defaulting to the node's
+	// default chain
+	ChainID *utils.Big `json:"chainId"`
+	// Address of the node's key sending the transaction, defaulting to the
+	// next key in round robin
+	FromAddress *common.Address `json:"fromAddress,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -47,8 +53,10 @@ func (etx *EthTxABIEncode) UnmarshalJSON(data []byte) error {
 			Name   string
 			Inputs abi.Arguments
 		}
-		GasPrice *utils.Big
-		GasLimit uint64
+		GasPrice    *utils.Big
+		GasLimit    uint64
+		ChainID     *utils.Big
+		FromAddress *common.Address
 	}
 
 	decoder := json.NewDecoder(bytes.NewReader(data))
@@ -62,6 +70,8 @@ func (etx *EthTxABIEncode) UnmarshalJSON(data []byte) error {
 	etx.FunctionABI.Inputs = fields.FunctionABI.Inputs
 	etx.GasPrice = fields.GasPrice
 	etx.GasLimit = fields.GasLimit
+	etx.ChainID = fields.ChainID
+	etx.FromAddress = fields.FromAddress
 	return nil
 }
 
@@ -69,7 +79,11 @@ func (etx *EthTxABIEncode) UnmarshalJSON(data []byte) error {
 // is not currently pending. Then it confirms the transaction was confirmed on
 // the blockchain.
 func (etx *EthTxABIEncode) Perform(input models.RunInput, store *strpkg.Store) models.RunOutput {
-	if !store.TxManager.Connected() {
+	chain, err := store.Chain(etx.ChainID.ToInt())
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	if !chain.TxManager.Connected() {
 		return pendingOutgoingConfirmationsOrConnection(input)
 	}
 	if !input.Status().PendingOutgoingConfirmations() {
@@ -78,9 +92,9 @@ func (etx *EthTxABIEncode) Perform(input models.RunInput, store *strpkg.Store) m
 			err = errors.Wrap(err, "while constructing EthTxABIEncode data")
 			return models.NewRunOutputError(err)
 		}
-		return createTxRunResult(etx.Address, etx.GasPrice, etx.GasLimit, data, input, store)
+		return createTxRunResult(etx.FromAddress, etx.Address, etx.GasPrice, etx.GasLimit, data, input, chain.TxManager)
 	}
-	return ensureTxRunResult(input, store)
+	return ensureTxRunResult(input, chain.TxManager)
 }
 
 // abiEncode ABI-encodes the arguments passed in a RunResult's result field
@@ -16,10 +16,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v3"
 )
 
 func TestEthTxAdapter_Perform(t *testing.T) {
@@ -252,6 +254,26 @@ func TestEthTxAdapter_Perform_WithError(t *testing.T) {
 	txManager.AssertExpectations(t)
 }
 
+func TestEthTxAdapter_Perform_WithRevertingGasEstimate(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, strpkg.ErrTxWouldRevert)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{}
+	input := cltest.NewRunInputWithResult("0x9786856756")
+	output := adapter.Perform(input, store)
+	assert.Error(t, output.Error())
+	assert.Equal(t, models.RunStatusErrored, output.Status())
+
+	txManager.AssertExpectations(t)
+}
+
 func TestEthTxAdapter_Perform_PendingOutgoingConfirmations_WithFatalErrorInTxManager(t *testing.T) {
 	t.Parallel()
 
@@ -477,3 +499,265 @@ func TestEthTxAdapter_Perform_NoDoubleSpendOnSendTransactionFail(t *testing.T) {
 
 	txManager.AssertExpectations(t)
 }
+
+func TestEthTxAdapter_Perform_BatchesOracleFulfillments(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	oracle := cltest.NewAddress()
+	input := *models.NewRunInputWithResult(models.NewID(), "0x1234", models.RunStatusUnstarted)
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	txManager.On("BatchingEnabled").Return(true)
+	txManager.On("CreateBatchedTx", input.JobRunID(), oracle, mock.Anything, uint64(0)).Return(3, nil)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{
+		Address:          oracle,
+		FunctionSelector: eth.HexToFunctionSelector(models.OracleFulfillmentFunctionID20190128withoutCast),
+	}
+	output := adapter.Perform(input, store)
+
+	require.NoError(t, output.Error())
+	assert.True(t, output.Status().PendingOutgoingConfirmations())
+	assert.Equal(t, int64(3), output.Get("batchIndex").Int())
+	assert.False(t, output.Get("batchForwarder").Exists(), "the batch is not sent yet")
+
+	txManager.AssertExpectations(t)
+}
+
+func TestEthTxAdapter_Perform_AttachesSentBatch(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	store.TxManager = txManager
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	adapter := adapters.EthTx{
+		Address:          cltest.NewAddress(),
+		FunctionSelector: eth.HexToFunctionSelector(models.OracleFulfillmentFunctionID20190128withoutCast),
+	}
+	queued := func(t *testing.T, call *models.TxBatchCall) models.RunInput {
+		run := cltest.CreateJobRunWithStatus(t, store, job, models.RunStatusPendingOutgoingConfirmations)
+		call.JobRunID = run.ID
+		call.CallIndex = 2
+		require.NoError(t, store.CreateTxBatchCall(call))
+		data := cltest.JSONFromString(t, `{"batchIndex":2}`)
+		return *models.NewRunInput(run.ID, data, models.RunStatusPendingOutgoingConfirmations)
+	}
+
+	t.Run("not sent yet", func(t *testing.T) {
+		output := adapter.Perform(queued(t, &models.TxBatchCall{}), store)
+		require.NoError(t, output.Error())
+		assert.True(t, output.Status().PendingOutgoingConfirmations())
+		assert.False(t, output.Get("result").Exists())
+	})
+
+	t.Run("sent", func(t *testing.T) {
+		tx := cltest.CreateTx(t, store, cltest.NewAddress(), 1)
+		output := adapter.Perform(queued(t, &models.TxBatchCall{TxID: &tx.ID}), store)
+		require.NoError(t, output.Error())
+		assert.True(t, output.Status().PendingOutgoingConfirmations())
+		assert.Equal(t, tx.Hash.String(), output.Result().String())
+		assert.Equal(t, tx.To.Hex(), output.Get("batchForwarder").String())
+		assert.Equal(t, int64(2), output.Get("batchIndex").Int())
+	})
+
+	t.Run("failed to send", func(t *testing.T) {
+		output := adapter.Perform(queued(t, &models.TxBatchCall{Error: null.StringFrom("insufficient funds")}), store)
+		assert.Error(t, output.Error())
+	})
+}
+
+func TestEthTxAdapter_Perform_FromPendingOutgoingConfirmations_BatchedCallResult(t *testing.T) {
+	t.Parallel()
+
+	forwarder := cltest.NewAddress()
+	callResult := func(index int64, success bool) eth.Log {
+		data := make([]byte, 32)
+		if success {
+			data[31] = 1
+		}
+		return eth.Log{
+			Address: forwarder,
+			Topics:  []common.Hash{eth.MulticallForwarderCallResultTopic, common.BigToHash(big.NewInt(index))},
+			Data:    data,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		logs      []eth.Log
+		wantError bool
+	}{
+		{"succeeded", []eth.Log{callResult(0, false), callResult(1, true)}, false},
+		{"reverted", []eth.Log{callResult(0, true), callResult(1, false)}, true},
+		{"missing event", []eth.Log{callResult(0, true)}, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore(t)
+			defer cleanup()
+
+			receipt := &eth.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.Int(129831), Logs: test.logs}
+			txManager := new(mocks.TxManager)
+			txManager.On("Connected").Return(true)
+			txManager.On("BumpGasUntilSafe", mock.Anything).Return(receipt, strpkg.Safe, nil)
+			store.TxManager = txManager
+
+			data := cltest.JSONFromString(t, `{"result": %q, "batchForwarder": %q, "batchIndex": 1}`, cltest.NewHash().Hex(), forwarder.Hex())
+			input := *models.NewRunInput(models.NewID(), data, models.RunStatusPendingOutgoingConfirmations)
+			adapter := adapters.EthTx{}
+			output := adapter.Perform(input, store)
+
+			if test.wantError {
+				assert.Error(t, output.Error())
+			} else {
+				require.NoError(t, output.Error())
+				assert.Equal(t, models.RunStatusCompleted, output.Status())
+			}
+			txManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEthTxAdapter_Perform_WithGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_GAS_PRICE_DEFAULT", 1000)
+	config.Set("ETH_MIN_GAS_PRICE_WEI", 5000)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	address := cltest.NewAddress()
+	tx := &models.Tx{Hash: cltest.NewHash(), Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	txManager.On("CreateTxWithGas", mock.Anything, address, mock.Anything, big.NewInt(5000), uint64(0)).Return(tx, nil)
+	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&eth.TxReceipt{}, strpkg.Unconfirmed, nil)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{
+		Address:      address,
+		GasEstimator: strpkg.GasEstimatorFixed,
+	}
+	input := cltest.NewRunInputWithResult("0x1234")
+	output := adapter.Perform(input, store)
+
+	require.NoError(t, output.Error())
+	assert.Equal(t, tx.Hash.String(), output.Result().String())
+
+	txManager.AssertExpectations(t)
+}
+
+func TestEthTxAdapter_Perform_WithGasEstimatorNotDev(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("CHAINLINK_DEV", false)
+	config.Set("ETH_GAS_PRICE_DEFAULT", 1000)
+	config.Set("ETH_MIN_GAS_PRICE_WEI", 5000)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	store := app.Store
+
+	app.EthMock.Context("app.Start()", func(meth *cltest.EthMock) {
+		meth.Register("eth_getTransactionCount", "0x1")
+		meth.Register("eth_chainId", store.Config.ChainID())
+	})
+	require.NoError(t, app.StartAndConnect())
+
+	hash := cltest.NewHash()
+	app.EthMock.Register("eth_sendRawTransaction", hash,
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			tx, err := utils.DecodeEthereumTx(rlp)
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(5000), tx.GasPrice())
+			return nil
+		})
+	app.EthMock.Register("eth_getTransactionReceipt", eth.TxReceipt{})
+
+	adapter := adapters.EthTx{
+		Address:      cltest.NewAddress(),
+		GasEstimator: strpkg.GasEstimatorFixed,
+	}
+	output := adapter.Perform(cltest.NewRunInputWithResult("0x1234"), store)
+	require.NoError(t, output.Error())
+
+	app.EthMock.EventuallyAllCalled(t)
+}
+
+func TestEthTxAdapter_Perform_GasPriceIgnoredNotDev(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("CHAINLINK_DEV", false)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	address := cltest.NewAddress()
+	tx := &models.Tx{Hash: cltest.NewHash(), Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	txManager.On("CreateTxWithGas", mock.Anything, address, mock.Anything, (*big.Int)(nil), uint64(0)).Return(tx, nil)
+	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&eth.TxReceipt{}, strpkg.Unconfirmed, nil)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{
+		Address:  address,
+		GasPrice: utils.NewBig(big.NewInt(1000000000000000)),
+	}
+	output := adapter.Perform(cltest.NewRunInputWithResult("0x1234"), store)
+	require.NoError(t, output.Error())
+
+	txManager.AssertExpectations(t)
+}
+
+func TestEthTxAdapter_Perform_FromAddress(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	oracle := cltest.NewAddress()
+	from := cltest.NewAddress()
+	tx := &models.Tx{Hash: cltest.NewHash(), From: from, Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+
+	txManager := new(mocks.TxManager)
+	txManager.On("Connected").Return(true)
+	txManager.On("BatchingEnabled").Return(true).Maybe()
+	txManager.On("CreateTxWithGasFrom", mock.Anything, from, oracle, mock.Anything, mock.Anything, uint64(0)).Return(tx, nil)
+	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&eth.TxReceipt{}, strpkg.Unconfirmed, nil)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{
+		Address:          oracle,
+		FunctionSelector: eth.HexToFunctionSelector(models.OracleFulfillmentFunctionID20190128withoutCast),
+		FromAddress:      &from,
+	}
+	input := cltest.NewRunInputWithResult("0x1234")
+	output := adapter.Perform(input, store)
+
+	require.NoError(t, output.Error())
+	assert.True(t, output.Status().PendingOutgoingConfirmations())
+	assert.Equal(t, tx.Hash.String(), output.Result().String())
+
+	txManager.AssertExpectations(t)
+}
@@ -113,6 +113,87 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "chains",
+			Usage: "Commands for the EVM chains served by the node",
+			Subcommands: []cli.Command{
+				{
+					Name:   "create",
+					Usage:  "Add a chain, served once the node restarts",
+					Action: client.CreateChain,
+				},
+				{
+					Name:   "destroy",
+					Usage:  "Remove a chain, no longer served once the node restarts",
+					Action: client.RemoveChain,
+				},
+				{
+					Name:   "list",
+					Usage:  "List the default chain and every additional chain",
+					Action: client.IndexChains,
+				},
+			},
+		},
+
+		{
+			Name:  "keys",
+			Usage: "Commands for managing the node's Ethereum keys",
+			Subcommands: []cli.Command{
+				{
+					Name:  "list",
+					Usage: "List the keys with their usage and state",
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "receiving",
+							Usage: "only list the keys that may be given out to receive funds",
+						},
+					},
+					Action: client.ListETHKeys,
+				},
+				{
+					Name:   "import",
+					Usage:  "Import an encrypted JSON key file, re-encrypting it with the node password",
+					Flags:  flags("oldpassword, p"),
+					Action: client.ImportETHKey,
+				},
+				{
+					Name:   "export",
+					Usage:  "Export a key to an encrypted JSON key file",
+					Flags:  append(flags("newpassword, p"), flags("output, o")...),
+					Action: client.ExportETHKey,
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a key without unconfirmed transactions from the node",
+					Action: client.DeleteETHKey,
+				},
+				{
+					Name:  "update",
+					Usage: "Change what a key is used for, or disable or enable it",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "usage",
+							Usage: "send_receive, send_only or receive_only",
+						},
+						cli.BoolFlag{
+							Name:  "disable",
+							Usage: "stop using the key",
+						},
+						cli.BoolFlag{
+							Name:  "enable",
+							Usage: "use the key again",
+						},
+					},
+					Action: client.UpdateETHKey,
+				},
+				{
+					Name:   "rotate",
+					Usage:  "Replace a key with a new one, disabling it once its pending transactions are confirmed",
+					Action: client.RotateETHKey,
+				},
+			},
+		},
+
 		{
 			Name:  "bridges",
 			Usage: "Commands for Bridges communicating with External Adapters",
@@ -206,6 +287,69 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show a specific Job's details",
 					Action: client.ShowJobSpec,
 				},
+				{
+					Name:   "pause",
+					Usage:  "Pause the flux monitor of a Job, keeping its spec and history",
+					Action: client.PauseJobSpec,
+				},
+				{
+					Name:   "resume",
+					Usage:  "Resume the paused flux monitor of a Job",
+					Action: client.ResumeJobSpec,
+				},
+				{
+					Name:   "fluxrounds",
+					Usage:  "List the decisions and submissions of a flux monitor Job, most recent first",
+					Action: client.IndexFluxMonitorRounds,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
+				{
+					Name:        "backtest",
+					Usage:       "Replay a price series through flux monitor thresholds and timers, and report what each combination would have submitted",
+					Description: "Takes the ID of a flux monitor Job to replay the answers it polled, or a CSV file of time,answer rows",
+					Action:      client.BacktestFluxMonitor,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "CSV file of time (unix seconds or RFC3339),answer rows to replay",
+						},
+						cli.StringFlag{
+							Name:  "threshold",
+							Usage: "comma separated relative thresholds, in percent",
+							Value: "0.5",
+						},
+						cli.StringFlag{
+							Name:  "absoluteThreshold",
+							Usage: "comma separated absolute thresholds",
+							Value: "0",
+						},
+						cli.StringFlag{
+							Name:  "pollPeriod",
+							Usage: "comma separated poll timer periods, 0 disabling the poll timer",
+							Value: "1m",
+						},
+						cli.StringFlag{
+							Name:  "idleDuration",
+							Usage: "comma separated idle timer durations, 0 disabling the idle timer",
+							Value: "0",
+						},
+						cli.Uint64Flag{
+							Name:  "gasLimit",
+							Usage: "gas used by each submission",
+							Value: 500000,
+						},
+						cli.StringFlag{
+							Name:  "gasPrice",
+							Usage: "gas price of each submission, in wei",
+							Value: "20000000000",
+						},
+					},
+				},
 			},
 		},
 
@@ -256,6 +400,34 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Run the chainlink node",
 					Action: client.RunNode,
 				},
+				{
+					Name:  "replay",
+					Usage: "Scan the logs of a block range again, creating the runs of log initiated jobs that were missed",
+					Description: format(`Asks the running node to fetch the logs of the block range for
+           the runlog, ethlog, randomnesslog and execagreement initiators, and to
+           create runs for the logs not already consumed by their job. The replay
+           runs in the background; its progress is served by
+           /v2/log_replays/<id>.`),
+					Flags: []cli.Flag{
+						cli.Uint64Flag{
+							Name:  "from",
+							Usage: "first block of the range",
+						},
+						cli.Uint64Flag{
+							Name:  "to",
+							Usage: "last block of the range",
+						},
+						cli.StringFlag{
+							Name:  "job",
+							Usage: "only replay the logs of the job with this ID",
+						},
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "replay the logs of the chain with this ID instead of the default chain",
+						},
+					},
+					Action: client.ReplayLogs,
+				},
 				cli.Command{
 					Name: "vrf",
 					Usage: format(`Local commands for administering the database of VRF proof
@@ -444,6 +616,10 @@ func NewApp(client *Client) *cli.App {
 							Name:  "page",
 							Usage: "page of results to display",
 						},
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "only list the transactions sent on the chain with this ID",
+						},
 					},
 				},
 				{
@@ -453,6 +629,30 @@ func NewApp(client *Client) *cli.App {
 				},
 			},
 		},
+
+		{
+			Name:  "vrf",
+			Usage: "Commands for generating verifiable randomness with the node's VRF keys",
+			Subcommands: []cli.Command{
+				{
+					Name:  "prove",
+					Usage: "Generate the proof of the VRF output for a seed, under one of the node's unlocked VRF keys",
+					Description: format(`Returns the random output and its proof, which can be verified
+           by VRF.sol#randomValueFromVRFProof.`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "publicKey, pk",
+							Usage: "compressed hex representation of the VRF public key",
+						},
+						cli.StringFlag{
+							Name:  "seed",
+							Usage: "seed of the VRF output, as a decimal or 0x-prefixed hex uint256",
+						},
+					},
+					Action: client.ProveVRF,
+				},
+			},
+		},
 	}...)
 	return app
 }
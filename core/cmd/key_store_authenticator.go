@@ -25,9 +25,12 @@ type TerminalKeyStoreAuthenticator struct {
 // Authenticate checks to see if there are accounts present in
 // the KeyStore, and if there are none, a new account will be created
 // by prompting for a password. If there are accounts present, the
-// account which is unlocked by the given password will be used.
+// account which is unlocked by the given password will be used. A remote
+// signer holds its own keys, so no password is needed to use it.
 func (auth TerminalKeyStoreAuthenticator) Authenticate(store *store.Store, pwd string) (string, error) {
-	if len(pwd) != 0 {
+	if isRemoteSigner(store.KeyStore) {
+		return pwd, checkPassword(store, pwd)
+	} else if len(pwd) != 0 {
 		return auth.authenticateWithPwd(store, pwd)
 	} else if auth.Prompter.IsTerminal() {
 		return auth.authenticationPrompt(store)
@@ -36,6 +39,11 @@ func (auth TerminalKeyStoreAuthenticator) Authenticate(store *store.Store, pwd s
 	}
 }
 
+func isRemoteSigner(keyStore store.KeyStoreInterface) bool {
+	_, ok := keyStore.(*store.RemoteKeyStore)
+	return ok
+}
+
 func (auth TerminalKeyStoreAuthenticator) authenticationPrompt(store *store.Store) (string, error) {
 	if store.KeyStore.HasAccounts() {
 		return auth.promptAndCheckPasswordLoop(store), nil
@@ -226,7 +226,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) error {
 		return err
 	}
 
-	lastHead, err := store.LastHead()
+	lastHead, err := store.LastHead(store.Config.ChainID())
 	if err != nil {
 		return err
 	}
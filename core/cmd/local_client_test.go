@@ -328,6 +328,7 @@ func TestClient_RebroadcastTransactions_WithinRange(t *testing.T) {
 			store.TxManager = txManager
 			txManager.On("Connect", mock.Anything).Return(nil)
 			txManager.On("Register", mock.Anything)
+			txManager.On("Retire", mock.Anything)
 			txManager.On("SignedRawTxWithBumpedGas", mock.MatchedBy(func(dbtx models.Tx) bool {
 				return dbtx.ID == tx.ID
 			}), gasLimit, *gasPrice).Return(bumpedRaw, nil)
@@ -410,6 +411,7 @@ func TestClient_RebroadcastTransactions_OutsideRange(t *testing.T) {
 
 			txManager.On("Connect", mock.Anything).Return(nil)
 			txManager.On("Register", mock.Anything)
+			txManager.On("Retire", mock.Anything)
 
 			assert.NoError(t, client.RebroadcastTransactions(c))
 
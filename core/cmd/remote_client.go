@@ -153,6 +153,15 @@ func (cli *Client) ShowJobSpec(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &job)
 }
 
+// IndexFluxMonitorRounds lists the decisions and submissions of the flux
+// monitor of the given job, most recent first.
+func (cli *Client) IndexFluxMonitorRounds(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id of the flux monitor"))
+	}
+	return cli.getPage("/v2/specs/"+c.Args().First()+"/flux_rounds", c.Int("page"), &[]models.FluxMonitorRound{})
+}
+
 // IndexJobSpecs returns all job specs.
 func (cli *Client) IndexJobSpecs(c *clipkg.Context) error {
 	return cli.getPage("/v2/specs", c.Int("page"), &[]models.JobSpec{})
@@ -195,6 +204,34 @@ func (cli *Client) ArchiveJobSpec(c *clipkg.Context) error {
 	return nil
 }
 
+// PauseJobSpec stops the flux monitor of a job from polling and submitting
+// answers, until resumed.
+func (cli *Client) PauseJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id to be paused"))
+	}
+	return cli.putJobSpec("/v2/specs/" + c.Args().First() + "/pause")
+}
+
+// ResumeJobSpec lets the paused flux monitor of a job poll and submit answers
+// again.
+func (cli *Client) ResumeJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id to be resumed"))
+	}
+	return cli.putJobSpec("/v2/specs/" + c.Args().First() + "/resume")
+}
+
+func (cli *Client) putJobSpec(path string) error {
+	resp, err := cli.HTTP.Put(path, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var job presenters.JobSpec
+	return cli.renderAPIResponse(resp, &job)
+}
+
 // CreateJobRun creates job run based on SpecID and optional JSON
 func (cli *Client) CreateJobRun(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -450,9 +487,61 @@ func (cli *Client) ChangePassword(c *clipkg.Context) error {
 // IndexTransactions returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTransactions(c *clipkg.Context) error {
+	if chainID := c.String("chain"); chainID != "" {
+		return cli.getPage("/v2/chains/"+chainID+"/transactions", c.Int("page"), &[]presenters.Tx{})
+	}
 	return cli.getPage("/v2/transactions", c.Int("page"), &[]presenters.Tx{})
 }
 
+// IndexChains lists the chains known to the node.
+func (cli *Client) IndexChains(c *clipkg.Context) error {
+	resp, err := cli.HTTP.Get("/v2/chains")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var chains []presenters.Chain
+	return cli.renderAPIResponse(resp, &chains)
+}
+
+// CreateChain adds a chain to be served by the node after its next restart.
+func (cli *Client) CreateChain(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass in the chain's parameters [JSON blob | JSON filepath]"))
+	}
+
+	buf, err := getBufferFromJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/chains", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var chain presenters.Chain
+	return cli.renderAPIResponse(resp, &chain)
+}
+
+// RemoveChain deletes a chain; the node stops serving it after its next
+// restart.
+func (cli *Client) RemoveChain(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the chain to be removed"))
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/chains/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	_, err = cli.parseResponse(resp)
+	return err
+}
+
 // ShowTransaction returns the info for the given transaction hash
 func (cli *Client) ShowTransaction(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -653,3 +742,75 @@ func (cli *Client) CancelJobRun(c *clipkg.Context) error {
 	}
 	return nil
 }
+
+// ReplayLogs asks the node to scan the logs of a block range again, creating
+// the runs of log initiated jobs that were missed
+func (cli *Client) ReplayLogs(c *clipkg.Context) error {
+	if !c.IsSet("from") || !c.IsSet("to") {
+		return cli.errorOut(errors.New("Must pass the block range to replay with --from and --to"))
+	}
+
+	fromBlock, toBlock := c.Uint64("from"), c.Uint64("to")
+	request := models.LogReplayRequest{
+		FromBlock: &fromBlock,
+		ToBlock:   &toBlock,
+	}
+	if c.IsSet("job") {
+		jobID, err := models.NewIDFromString(c.String("job"))
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid job ID"))
+		}
+		request.JobID = jobID
+	}
+	if c.IsSet("chain") {
+		chainID, ok := new(big.Int).SetString(c.String("chain"), 10)
+		if !ok {
+			return cli.errorOut(fmt.Errorf("invalid chain ID %s", c.String("chain")))
+		}
+		request.ChainID = utils.NewBig(chainID)
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/log_replays", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var replay models.LogReplay
+	return cli.renderAPIResponse(resp, &replay)
+}
+
+// ProveVRF asks the node for the proof of the VRF output for a seed, under
+// one of its unlocked VRF keys
+func (cli *Client) ProveVRF(c *clipkg.Context) error {
+	if !c.IsSet("publicKey") || !c.IsSet("seed") {
+		return cli.errorOut(errors.New("Must pass the public key with --publicKey and the seed with --seed"))
+	}
+
+	request := models.VRFProofRequest{Seed: new(utils.Big)}
+	if err := request.PublicKey.SetFromHex(c.String("publicKey")); err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid public key"))
+	}
+	if err := request.Seed.UnmarshalText([]byte(c.String("seed"))); err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid seed"))
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/vrf/proofs", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var proof web.VRFProofResponse
+	return cli.renderAPIResponse(resp, &proof)
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/zap/zapcore"
+)
+
+// BacktestFluxMonitor replays a price series, read from a CSV file or from
+// the answers polled by a flux monitor job, through every combination of the
+// given thresholds and timers, and reports what each would have submitted.
+func (cli *Client) BacktestFluxMonitor(c *clipkg.Context) error {
+	var series []fluxmonitor.PricePoint
+	var err error
+	if file := c.String("file"); file != "" {
+		series, err = readPriceSeries(file)
+	} else if c.Args().Present() {
+		series, err = cli.recordedPriceSeries(c.Args().First())
+	} else {
+		return cli.errorOut(errors.New("Must pass the job id of the flux monitor, or a price series file"))
+	}
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	grid, err := backtestParamsGrid(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	gasPrice, ok := new(big.Int).SetString(c.String("gasPrice"), 10)
+	if !ok {
+		return cli.errorOut(fmt.Errorf("invalid gasPrice %s", c.String("gasPrice")))
+	}
+
+	// The deviation checks log each of their decisions, which would bury the
+	// report
+	log := &logger.Logger{
+		SugaredLogger: logger.CreateProductionLogger("", false, zapcore.WarnLevel, false).Sugar(),
+	}
+
+	results := make([]fluxmonitor.BacktestResult, len(grid))
+	for i, params := range grid {
+		results[i], err = fluxmonitor.Backtest(series, params, c.Uint64("gasLimit"), gasPrice, log)
+		if err != nil {
+			return cli.errorOut(err)
+		}
+	}
+	return cli.errorOut(cli.Render(&results))
+}
+
+func readPriceSeries(path string) ([]fluxmonitor.PricePoint, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return fluxmonitor.ParsePriceSeries(file)
+}
+
+// recordedPriceSeries pages through the rounds recorded for the job, and
+// returns the answers it polled in chronological order.
+func (cli *Client) recordedPriceSeries(jobID string) ([]fluxmonitor.PricePoint, error) {
+	var series []fluxmonitor.PricePoint
+	uri := "/v2/specs/" + jobID + "/flux_rounds?size=1000"
+	for uri != "" {
+		resp, err := cli.HTTP.Get(uri)
+		if err != nil {
+			return nil, err
+		}
+		var rounds []models.FluxMonitorRound
+		links := jsonapi.Links{}
+		err = cli.deserializeAPIResponse(resp, &rounds, &links)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, round := range rounds {
+			if round.Answer.Valid {
+				series = append(series, fluxmonitor.PricePoint{Time: round.CreatedAt, Answer: round.Answer.Decimal})
+			}
+		}
+		uri = links["next"].Href
+	}
+
+	if len(series) == 0 {
+		return nil, fmt.Errorf("no answers were recorded for job %s", jobID)
+	}
+	sort.SliceStable(series, func(i, j int) bool {
+		return series[i].Time.Before(series[j].Time)
+	})
+	return series, nil
+}
+
+// backtestParamsGrid returns every combination of the comma separated
+// values of the threshold and timer flags.
+func backtestParamsGrid(c *clipkg.Context) ([]fluxmonitor.BacktestParams, error) {
+	thresholds, err := parseFloats(c.String("threshold"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid threshold")
+	}
+	absoluteThresholds, err := parseFloats(c.String("absoluteThreshold"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid absoluteThreshold")
+	}
+	pollPeriods, err := parseDurations(c.String("pollPeriod"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pollPeriod")
+	}
+	idleDurations, err := parseDurations(c.String("idleDuration"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid idleDuration")
+	}
+
+	var grid []fluxmonitor.BacktestParams
+	for _, threshold := range thresholds {
+		for _, absoluteThreshold := range absoluteThresholds {
+			for _, pollPeriod := range pollPeriods {
+				for _, idleDuration := range idleDurations {
+					grid = append(grid, fluxmonitor.BacktestParams{
+						Threshold:         threshold,
+						AbsoluteThreshold: absoluteThreshold,
+						PollPeriod:        pollPeriod,
+						IdleDuration:      idleDuration,
+					})
+				}
+			}
+		}
+	}
+	return grid, nil
+}
+
+func parseFloats(list string) ([]float64, error) {
+	var values []float64
+	for _, s := range strings.Split(list, ",") {
+		value, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func parseDurations(list string) ([]models.Duration, error) {
+	var values []models.Duration
+	for _, s := range strings.Split(list, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		value, err := models.MakeDuration(d)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+)
+
+// ListETHKeys lists the ethereum keys of the node with their usage and state,
+// only those that may be given out to receive funds with --receiving.
+func (cli *Client) ListETHKeys(c *clipkg.Context) error {
+	path := "/v2/keys"
+	if c.Bool("receiving") {
+		path += "?receiving=true"
+	}
+	resp, err := cli.HTTP.Get(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var keys []presenters.ETHKey
+	return cli.renderAPIResponse(resp, &keys)
+}
+
+// ImportETHKey imports an encrypted JSON key file, protected by the password
+// in the file given by --oldpassword, into the node's keystore.
+func (cli *Client) ImportETHKey(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the key to be imported"))
+	}
+	if !c.IsSet("oldpassword") {
+		return cli.errorOut(errors.New("Must specify --oldpassword/-p flag"))
+	}
+	keyJSON, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	keyPassword, err := passwordFromFile(c.String("oldpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "could not read password file"))
+	}
+
+	resp, err := cli.postKeyRequest("/v2/keys/import", models.ImportKeyRequest{
+		CurrentPassword: cli.PasswordPrompter.Prompt(),
+		KeyPassword:     keyPassword,
+		Key:             keyJSON,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var key presenters.ETHKey
+	return cli.renderAPIResponse(resp, &key)
+}
+
+// ExportETHKey writes a key of the node to the file given by --output,
+// encrypted with the password in the file given by --newpassword.
+func (cli *Client) ExportETHKey(c *clipkg.Context) error {
+	address, err := addressArgument(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	if !c.IsSet("newpassword") {
+		return cli.errorOut(errors.New("Must specify --newpassword/-p flag"))
+	}
+	if !c.IsSet("output") {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+	filepath := c.String("output")
+	if _, err := os.Stat(filepath); err == nil {
+		return cli.errorOut(fmt.Errorf("refusing to overwrite existing file %s", filepath))
+	}
+	newPassword, err := passwordFromFile(c.String("newpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "could not read password file"))
+	}
+
+	resp, err := cli.postKeyRequest("/v2/keys/export", models.ExportKeyRequest{
+		Address:         address,
+		CurrentPassword: cli.PasswordPrompter.Prompt(),
+		NewPassword:     newPassword,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	keyJSON, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	if err := utils.WriteFileWithPerms(filepath, keyJSON, 0600); err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Exported key %s to %s\n", address.Hex(), filepath)
+	return nil
+}
+
+// DeleteETHKey removes a key from the node. Keys with pending transactions
+// must be rotated instead.
+func (cli *Client) DeleteETHKey(c *clipkg.Context) error {
+	address, err := addressArgument(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.postKeyRequest("/v2/keys/delete", models.DeleteKeyRequest{
+		Address:         address,
+		CurrentPassword: cli.PasswordPrompter.Prompt(),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Printf("Deleted key %s\n", address.Hex())
+	return nil
+}
+
+// RotateETHKey creates a new key replacing the given one, which is disabled
+// once its pending transactions are confirmed.
+func (cli *Client) RotateETHKey(c *clipkg.Context) error {
+	address, err := addressArgument(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.postKeyRequest("/v2/keys/rotate", models.RotateKeyRequest{
+		Address:         address,
+		CurrentPassword: cli.PasswordPrompter.Prompt(),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var key presenters.ETHKey
+	return cli.renderAPIResponse(resp, &key)
+}
+
+// UpdateETHKey changes the usage of a key with --usage, or disables or
+// enables it with --disable or --enable.
+func (cli *Client) UpdateETHKey(c *clipkg.Context) error {
+	address, err := addressArgument(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	if c.Bool("disable") && c.Bool("enable") {
+		return cli.errorOut(errors.New("Cannot both --disable and --enable a key"))
+	}
+
+	request := models.UpdateKeyRequest{}
+	if c.IsSet("usage") {
+		usage := c.String("usage")
+		request.Usage = &usage
+	}
+	if c.Bool("disable") || c.Bool("enable") {
+		disabled := c.Bool("disable")
+		request.Disabled = &disabled
+	}
+	if request.Usage == nil && request.Disabled == nil {
+		return cli.errorOut(errors.New("Must specify --usage, --disable or --enable"))
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Patch("/v2/keys/"+address.Hex(), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+
+	var key presenters.ETHKey
+	return cli.renderAPIResponse(resp, &key)
+}
+
+func (cli *Client) postKeyRequest(path string, request interface{}) (*http.Response, error) {
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	return cli.HTTP.Post(path, bytes.NewBuffer(requestData))
+}
+
+func addressArgument(c *clipkg.Context) (common.Address, error) {
+	if !c.Args().Present() {
+		return common.Address{}, errors.New("Must pass the address of the key")
+	}
+	if !common.IsHexAddress(c.Args().First()) {
+		return common.Address{}, fmt.Errorf("invalid address %s", c.Args().First())
+	}
+	return common.HexToAddress(c.Args().First()), nil
+}
@@ -12,8 +12,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
+	"github.com/smartcontractkit/chainlink/core/web"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
@@ -389,6 +392,33 @@ func TestClient_ArchiveJobSpec(t *testing.T) {
 	require.Len(t, jobs, 0)
 }
 
+func TestClient_PauseResumeJobSpec(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.EthMockRegisterChainID)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("pause", 0)
+	set.Parse([]string{job.ID.String()})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.PauseJobSpec(c))
+	require.Len(t, r.Renders, 1)
+	assert.True(t, r.Renders[0].(*presenters.JobSpec).Initiators[0].Paused)
+
+	set = flag.NewFlagSet("resume", 0)
+	set.Parse([]string{job.ID.String()})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ResumeJobSpec(c))
+	require.Len(t, r.Renders, 2)
+	assert.False(t, r.Renders[1].(*presenters.JobSpec).Initiators[0].Paused)
+}
+
 func TestClient_CreateJobSpec_JSONAPIErrors(t *testing.T) {
 	t.Parallel()
 
@@ -887,6 +917,33 @@ func TestClient_CreateExtraKey(t *testing.T) {
 	kst.AssertExpectations(t)
 }
 
+func TestClient_ListAndUpdateETHKeys(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	address := cltest.GetAccountAddress(t, app.Store)
+
+	client, r := app.NewClientAndRenderer()
+
+	assert.NoError(t, client.ListETHKeys(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+	keys := *r.Renders[0].(*[]presenters.ETHKey)
+	require.Len(t, keys, 1)
+	assert.Equal(t, address.Hex(), keys[0].Address)
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("usage", "receive_only", "")
+	require.NoError(t, set.Parse([]string{address.Hex()}))
+	assert.NoError(t, client.UpdateETHKey(cli.NewContext(nil, set, nil)))
+	key := *r.Renders[1].(*presenters.ETHKey)
+	assert.Equal(t, models.KeyUsageReceiveOnly, key.Usage)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{address.Hex()}))
+	assert.Error(t, client.UpdateETHKey(cli.NewContext(nil, set, nil)))
+}
+
 func TestClient_SetMinimumGasPrice(t *testing.T) {
 	t.Parallel()
 
@@ -965,3 +1022,134 @@ func TestClient_CancelJobRun(t *testing.T) {
 	assert.Equal(t, models.RunStatusCancelled, runs[0].GetStatus())
 	assert.NotNil(t, runs[0].FinishedAt)
 }
+
+func TestClient_ReplayLogs(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	client, r := app.NewClientAndRenderer()
+	set := flag.NewFlagSet("replay", 0)
+	set.Uint64("from", 0, "")
+	set.Uint64("to", 0, "")
+	set.String("job", "", "")
+	require.NoError(t, set.Parse([]string{"-from", "1", "-to", "100", "-job", job.ID.String()}))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ReplayLogs(c))
+	require.Len(t, r.Renders, 1)
+	replay := r.Renders[0].(*models.LogReplay)
+	assert.NotZero(t, replay.ID)
+	assert.Equal(t, uint64(1), replay.FromBlock)
+	assert.Equal(t, uint64(100), replay.ToBlock)
+
+	set = flag.NewFlagSet("replay", 0)
+	set.Uint64("from", 0, "")
+	require.NoError(t, set.Parse([]string{"-from", "1"}))
+	assert.Error(t, client.ReplayLogs(cli.NewContext(nil, set, nil)))
+}
+
+func TestClient_ProveVRF(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	publicKey := cltest.StoredVRFKey(t, app.Store)
+
+	client, r := app.NewClientAndRenderer()
+	set := flag.NewFlagSet("prove", 0)
+	set.String("publicKey", "", "")
+	set.String("seed", "", "")
+	require.NoError(t, set.Parse([]string{"-publicKey", publicKey.String(), "-seed", "0x10"}))
+
+	require.NoError(t, client.ProveVRF(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	proof := r.Renders[0].(*web.VRFProofResponse)
+	assert.Equal(t, publicKey.MustHash(), proof.KeyHash)
+	assert.Equal(t, "16", proof.Seed.String())
+
+	set = flag.NewFlagSet("prove", 0)
+	set.String("publicKey", "", "")
+	require.NoError(t, set.Parse([]string{"-publicKey", publicKey.String()}))
+	assert.Error(t, client.ProveVRF(cli.NewContext(nil, set, nil)))
+}
+
+func TestClient_IndexFluxMonitorRounds(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	round := models.NewFluxMonitorRound(job.ID, 7, models.FluxMonitorTriggerIdle)
+	round.SkipReason = "aggregator is underfunded"
+	require.NoError(t, store.CreateFluxMonitorRound(round))
+
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{job.ID.String()})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.IndexFluxMonitorRounds(c))
+
+	rounds := *r.Renders[0].(*[]models.FluxMonitorRound)
+	require.Len(t, rounds, 1)
+	assert.Equal(t, uint32(7), rounds[0].RoundID)
+	assert.Equal(t, models.FluxMonitorTriggerIdle, rounds[0].Trigger)
+	assert.Equal(t, "aggregator is underfunded", rounds[0].SkipReason)
+
+	set = flag.NewFlagSet("test", 0)
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.IndexFluxMonitorRounds(c))
+}
+
+func TestClient_BacktestFluxMonitor(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client, r := app.NewClientAndRenderer()
+
+	tmpFile, err := ioutil.TempFile("", "series.*.csv")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+	_, err = tmpFile.WriteString("time,answer\n1591000000,100\n1591000090,101\n1591000150,110\n1591000600,110\n")
+	require.NoError(t, err)
+	require.NoError(t, tmpFile.Close())
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("file", tmpFile.Name(), "")
+	set.String("threshold", "0.5,5", "")
+	set.String("absoluteThreshold", "0", "")
+	set.String("pollPeriod", "1m", "")
+	set.String("idleDuration", "0,4m", "")
+	set.Uint64("gasLimit", 100000, "")
+	set.String("gasPrice", "10", "")
+	c := cli.NewContext(nil, set, nil)
+	globalLogger := logger.GetLogger()
+	require.NoError(t, client.BacktestFluxMonitor(c))
+	assert.Same(t, globalLogger, logger.GetLogger())
+
+	results := *r.Renders[0].(*[]fluxmonitor.BacktestResult)
+	require.Len(t, results, 4)
+	assert.Equal(t, 0.5, results[0].Threshold)
+	assert.True(t, results[0].IdleDuration.IsInstant())
+	assert.Equal(t, 3, results[0].Submissions)
+	assert.Equal(t, 5.0, results[2].Threshold)
+	assert.Equal(t, 2, results[2].Submissions)
+	assert.Equal(t, "10.00", results[2].MaxDeviation.StringFixed(2))
+
+	set = flag.NewFlagSet("test", 0)
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.BacktestFluxMonitor(c))
+}
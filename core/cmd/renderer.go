@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
@@ -14,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web"
 
 	"github.com/olekukonko/tablewriter"
+	"github.com/shopspring/decimal"
 )
 
 // Renderer implements the Render method.
@@ -75,8 +77,24 @@ func (rt RendererTable) Render(v interface{}) error {
 		return rt.renderExternalInitiatorAuthentication(*typed)
 	case *web.ConfigPatchResponse:
 		return rt.renderConfigPatchResponse(typed)
+	case *models.LogReplay:
+		return rt.renderLogReplay(typed)
+	case *web.VRFProofResponse:
+		return rt.renderVRFProofResponse(typed)
 	case *presenters.ConfigWhitelist:
 		return rt.renderConfiguration(*typed)
+	case *[]presenters.Chain:
+		return rt.renderChains(*typed)
+	case *presenters.Chain:
+		return rt.renderChains([]presenters.Chain{*typed})
+	case *[]presenters.ETHKey:
+		return rt.renderETHKeys(*typed)
+	case *presenters.ETHKey:
+		return rt.renderETHKeys([]presenters.ETHKey{*typed})
+	case *[]models.FluxMonitorRound:
+		return rt.renderFluxMonitorRounds(*typed)
+	case *[]fluxmonitor.BacktestResult:
+		return rt.renderBacktestResults(*typed)
 	default:
 		return fmt.Errorf("unable to render object of type %T: %v", typed, typed)
 	}
@@ -230,7 +248,7 @@ func (rt RendererTable) renderJobSingles(j presenters.JobSpec) error {
 }
 
 func (rt RendererTable) renderJobInitiators(j presenters.JobSpec) error {
-	table := rt.newTable([]string{"Type", "Schedule", "Run At", "Address"})
+	table := rt.newTable([]string{"Type", "Schedule", "Run At", "Address", "Paused"})
 	for _, i := range j.Initiators {
 		p := presenters.Initiator{Initiator: i}
 		table.Append([]string{
@@ -238,6 +256,7 @@ func (rt RendererTable) renderJobInitiators(j presenters.JobSpec) error {
 			p.Schedule.String(),
 			p.FriendlyRunAt(),
 			p.FriendlyAddress(),
+			fmt.Sprint(p.Paused),
 		})
 	}
 
@@ -276,10 +295,11 @@ func (rt RendererTable) renderJobRuns(runs []presenters.JobRun) error {
 }
 
 func (rt RendererTable) renderAccountBalances(balances []presenters.AccountBalance) error {
-	table := rt.newTable([]string{"Address", "ETH", "LINK"})
+	table := rt.newTable([]string{"Address", "Chain ID", "ETH", "LINK"})
 	for _, ab := range balances {
 		table.Append([]string{
 			ab.Address,
+			ab.ChainID,
 			ab.EthBalance.String(),
 			ab.LinkBalance.String(),
 		})
@@ -352,6 +372,100 @@ func (rt RendererTable) renderTx(tx presenters.Tx) error {
 	return nil
 }
 
+func (rt RendererTable) renderChains(chains []presenters.Chain) error {
+	table := rt.newTable([]string{"ID", "ETH URL", "Default", "Serving", "Connected", "Head"})
+	for _, chain := range chains {
+		head := ""
+		if chain.Head != nil {
+			head = chain.Head.String()
+		}
+		table.Append([]string{
+			chain.ID.String(),
+			chain.EthURL,
+			fmt.Sprint(chain.Default),
+			fmt.Sprint(chain.Serving),
+			fmt.Sprint(chain.Connected),
+			head,
+		})
+	}
+
+	render("Chains", table)
+	return nil
+}
+
+func (rt RendererTable) renderETHKeys(keys []presenters.ETHKey) error {
+	table := rt.newTable([]string{"Address", "Usage", "Disabled", "Retiring", "Created At"})
+	for _, key := range keys {
+		table.Append([]string{
+			key.Address,
+			string(key.Usage),
+			fmt.Sprint(key.Disabled),
+			fmt.Sprint(key.Retiring),
+			utils.ISO8601UTC(key.CreatedAt),
+		})
+	}
+
+	render("Keys", table)
+	return nil
+}
+
+func (rt RendererTable) renderFluxMonitorRounds(rounds []models.FluxMonitorRound) error {
+	table := rt.newTable([]string{"Round", "Trigger", "Answer", "Latest Answer", "Deviation", "Eligible", "Submitted", "Skip Reason", "Tx Hash", "Created At"})
+	for _, round := range rounds {
+		txHash := ""
+		if round.TxHash != nil {
+			txHash = round.TxHash.Hex()
+		}
+		table.Append([]string{
+			fmt.Sprint(round.RoundID),
+			string(round.Trigger),
+			nullDecimalToString(round.Answer),
+			nullDecimalToString(round.LatestAnswer),
+			nullDecimalToString(round.Deviation),
+			fmt.Sprint(round.Eligible),
+			fmt.Sprint(round.Submitted),
+			round.SkipReason,
+			txHash,
+			utils.ISO8601UTC(round.CreatedAt),
+		})
+	}
+
+	render("Flux Monitor Rounds", table)
+	return nil
+}
+
+func (rt RendererTable) renderBacktestResults(results []fluxmonitor.BacktestResult) error {
+	table := rt.newTable([]string{"Threshold", "Absolute Threshold", "Poll Period", "Idle Duration", "Submissions", "Max Deviation", "Gas Cost"})
+	for _, result := range results {
+		table.Append([]string{
+			fmt.Sprint(result.Threshold),
+			fmt.Sprint(result.AbsoluteThreshold),
+			timerToString(result.PollPeriod),
+			timerToString(result.IdleDuration),
+			fmt.Sprint(result.Submissions),
+			result.MaxDeviation.StringFixed(2) + "%",
+			result.GasCost.String(),
+		})
+	}
+
+	render("Backtest", table)
+	return nil
+}
+
+func timerToString(d models.Duration) string {
+	if d.IsInstant() {
+		return "disabled"
+	}
+	return d.String()
+}
+
+func nullDecimalToString(d decimal.NullDecimal) string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Decimal.String()
+}
+
 func (rt RendererTable) renderTxs(txs []presenters.Tx) error {
 	table := rt.newTable([]string{"Hash", "Nonce", "From", "GasPrice", "SentAt", "Confirmed"})
 	for _, tx := range txs {
@@ -379,3 +493,36 @@ func (rt RendererTable) renderConfigPatchResponse(config *web.ConfigPatchRespons
 	render("Configuration Changes", table)
 	return nil
 }
+
+func (rt RendererTable) renderLogReplay(replay *models.LogReplay) error {
+	table := rt.newTable([]string{"ID", "From Block", "To Block", "Status", "Logs Found", "Logs Replayed", "Logs Skipped", "Error"})
+	table.Append([]string{
+		replay.GetID(),
+		fmt.Sprint(replay.FromBlock),
+		fmt.Sprint(replay.ToBlock),
+		string(replay.Status),
+		fmt.Sprint(replay.LogsFound),
+		fmt.Sprint(replay.LogsReplayed),
+		fmt.Sprint(replay.LogsSkipped),
+		replay.Error.ValueOrZero(),
+	})
+	render("Log Replay", table)
+	return nil
+}
+
+func (rt RendererTable) renderVRFProofResponse(proof *web.VRFProofResponse) error {
+	table := rt.newTable([]string{"Public Key", "Key Hash", "Seed", "Proof Seed", "Output"})
+	table.Append([]string{
+		proof.PublicKey,
+		proof.KeyHash.Hex(),
+		proof.Seed.String(),
+		proof.ProofSeed.String(),
+		proof.Output.String(),
+	})
+	render("VRF Proof", table)
+
+	proofTable := rt.newTable([]string{"Hex"})
+	proofTable.Append([]string{proof.Proof})
+	render("Proof", proofTable)
+	return nil
+}
@@ -0,0 +1,139 @@
+package eth
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// BatchCaller sends several JSON-RPC calls to the ethereum node in a single
+// batch request. As with rpc.Client, BatchCall only returns an error if the
+// request as a whole failed; errors specific to a call are set on its
+// BatchElem.
+type BatchCaller interface {
+	BatchCall(b []rpc.BatchElem) error
+}
+
+// BatchingCallerSubscriber groups calls made concurrently on the underlying
+// CallerSubscriber into JSON-RPC batch requests of at most maxBatchSize calls.
+//
+// A call made while no batch is in flight is sent on its own straight away,
+// so batching never delays a lone caller; calls that arrive while a request
+// is in flight are queued and sent together once it returns.
+type BatchingCallerSubscriber struct {
+	CallerSubscriber
+	batcher      BatchCaller
+	maxBatchSize int
+
+	mutex    sync.Mutex
+	pending  []*batchRequest
+	flushing bool
+}
+
+type batchRequest struct {
+	elem rpc.BatchElem
+	done chan struct{}
+}
+
+// NewBatchingCallerSubscriber returns a CallerSubscriber batching concurrent
+// calls to cs. If cs cannot send batch requests or maxBatchSize is less than
+// 2, cs is returned unchanged.
+func NewBatchingCallerSubscriber(cs CallerSubscriber, maxBatchSize int) CallerSubscriber {
+	batcher, ok := cs.(BatchCaller)
+	if !ok || maxBatchSize < 2 {
+		return cs
+	}
+	return &BatchingCallerSubscriber{
+		CallerSubscriber: cs,
+		batcher:          batcher,
+		maxBatchSize:     maxBatchSize,
+	}
+}
+
+// Call queues the JSON-RPC call and blocks until the batch including it has
+// been answered.
+func (b *BatchingCallerSubscriber) Call(result interface{}, method string, args ...interface{}) error {
+	req := &batchRequest{
+		elem: rpc.BatchElem{Method: method, Args: args, Result: result},
+		done: make(chan struct{}),
+	}
+
+	b.mutex.Lock()
+	b.pending = append(b.pending, req)
+	flush := !b.flushing
+	b.flushing = true
+	b.mutex.Unlock()
+
+	if flush {
+		b.flush()
+	}
+	<-req.done
+	return req.elem.Error
+}
+
+// BatchCall sends the given calls in batches of at most maxBatchSize,
+// bypassing the queue of concurrent calls.
+func (b *BatchingCallerSubscriber) BatchCall(elems []rpc.BatchElem) error {
+	for start := 0; start < len(elems); start += b.maxBatchSize {
+		end := start + b.maxBatchSize
+		if end > len(elems) {
+			end = len(elems)
+		}
+		if err := b.batcher.BatchCall(elems[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flush sends the next batch of pending calls. If more calls were queued in
+// the meantime, another goroutine takes over so that the current caller can
+// return with its result.
+func (b *BatchingCallerSubscriber) flush() {
+	b.mutex.Lock()
+	size := len(b.pending)
+	if size > b.maxBatchSize {
+		size = b.maxBatchSize
+	}
+	batch := b.pending[:size]
+	b.pending = b.pending[size:]
+	b.mutex.Unlock()
+
+	b.send(batch)
+
+	b.mutex.Lock()
+	more := len(b.pending) > 0
+	b.flushing = more
+	b.mutex.Unlock()
+
+	if more {
+		go b.flush()
+	}
+}
+
+func (b *BatchingCallerSubscriber) send(batch []*batchRequest) {
+	defer func() {
+		for _, req := range batch {
+			close(req.done)
+		}
+	}()
+
+	if len(batch) == 1 {
+		elem := &batch[0].elem
+		elem.Error = b.CallerSubscriber.Call(elem.Result, elem.Method, elem.Args...)
+		return
+	}
+
+	elems := make([]rpc.BatchElem, len(batch))
+	for i, req := range batch {
+		elems[i] = req.elem
+	}
+	err := b.batcher.BatchCall(elems)
+	for i, req := range batch {
+		if err != nil {
+			req.elem.Error = err
+		} else {
+			req.elem.Error = elems[i].Error
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBatchCaller struct {
+	started  chan struct{}
+	release  chan struct{}
+	batchErr error
+
+	mutex      sync.Mutex
+	calls      int
+	batchSizes []int
+}
+
+func (f *fakeBatchCaller) Call(result interface{}, method string, args ...interface{}) error {
+	if f.started != nil {
+		f.started <- struct{}{}
+	}
+	<-f.release
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.calls++
+	if method == "fail" {
+		return errors.New("call failed")
+	}
+	*result.(*string) = method
+	return nil
+}
+
+func (f *fakeBatchCaller) BatchCall(b []rpc.BatchElem) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.batchSizes = append(f.batchSizes, len(b))
+	if f.batchErr != nil {
+		return f.batchErr
+	}
+	for i := range b {
+		if b[i].Method == "fail" {
+			b[i].Error = errors.New("call failed")
+			continue
+		}
+		*b[i].Result.(*string) = b[i].Method
+	}
+	return nil
+}
+
+func (f *fakeBatchCaller) Subscribe(context.Context, interface{}, ...interface{}) (Subscription, error) {
+	return nil, nil
+}
+
+type fakeCaller struct {
+	CallerSubscriber
+}
+
+func TestNewBatchingCallerSubscriber_Disabled(t *testing.T) {
+	cs := fakeCaller{}
+	assert.Equal(t, cs, NewBatchingCallerSubscriber(cs, 100), "cannot batch without BatchCall")
+
+	fake := &fakeBatchCaller{}
+	assert.Equal(t, fake, NewBatchingCallerSubscriber(fake, 1))
+}
+
+func TestBatchingCallerSubscriber_LoneCallIsNotBatched(t *testing.T) {
+	fake := &fakeBatchCaller{release: make(chan struct{})}
+	close(fake.release)
+	batching := NewBatchingCallerSubscriber(fake, 10)
+
+	var result string
+	require.NoError(t, batching.Call(&result, "eth_chainId"))
+	assert.Equal(t, "eth_chainId", result)
+	assert.Equal(t, 1, fake.calls)
+	assert.Empty(t, fake.batchSizes)
+}
+
+func runConcurrentCalls(t *testing.T, fake *fakeBatchCaller, maxBatchSize int, methods []string) ([]string, []error) {
+	batching := NewBatchingCallerSubscriber(fake, maxBatchSize).(*BatchingCallerSubscriber)
+
+	// The first call blocks in flight while the others are queued behind it
+	var first string
+	firstDone := make(chan error)
+	go func() { firstDone <- batching.Call(&first, "first") }()
+	<-fake.started
+
+	results := make([]string, len(methods))
+	errs := make([]error, len(methods))
+	var wg sync.WaitGroup
+	for i, method := range methods {
+		wg.Add(1)
+		go func(i int, method string) {
+			defer wg.Done()
+			errs[i] = batching.Call(&results[i], method)
+		}(i, method)
+	}
+
+	require.Eventually(t, func() bool {
+		batching.mutex.Lock()
+		defer batching.mutex.Unlock()
+		return len(batching.pending) == len(methods)
+	}, 5*time.Second, 10*time.Millisecond)
+	close(fake.release)
+	require.NoError(t, <-firstDone)
+	assert.Equal(t, "first", first)
+	wg.Wait()
+	return results, errs
+}
+
+func TestBatchingCallerSubscriber_BatchesConcurrentCalls(t *testing.T) {
+	fake := &fakeBatchCaller{started: make(chan struct{}, 1), release: make(chan struct{})}
+	results, errs := runConcurrentCalls(t, fake, 2, []string{"a", "fail", "c"})
+
+	// The call left over from the capped batch is sent on its own
+	assert.Equal(t, 2, fake.calls)
+	assert.Equal(t, []int{2}, fake.batchSizes)
+
+	for i, method := range []string{"a", "fail", "c"} {
+		if method == "fail" {
+			assert.EqualError(t, errs[i], "call failed")
+			continue
+		}
+		assert.NoError(t, errs[i])
+		assert.Equal(t, method, results[i])
+	}
+}
+
+func TestBatchingCallerSubscriber_BatchErrorFailsEveryCall(t *testing.T) {
+	fake := &fakeBatchCaller{started: make(chan struct{}, 1), release: make(chan struct{}), batchErr: errors.New("connection lost")}
+	_, errs := runConcurrentCalls(t, fake, 10, []string{"a", "b", "c"})
+
+	assert.Equal(t, []int{3}, fake.batchSizes)
+	for _, err := range errs {
+		assert.EqualError(t, err, "connection lost")
+	}
+}
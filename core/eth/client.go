@@ -27,6 +27,7 @@ type Client interface {
 	GetLatestBlock() (Block, error)
 	GetBlockByNumber(hex string) (Block, error)
 	GetChainID() (*big.Int, error)
+	EstimateGas(from, to common.Address, data []byte, value *big.Int) (uint64, error)
 	SubscribeToNewHeads(ctx context.Context, channel chan<- BlockHeader) (Subscription, error)
 }
 
@@ -90,10 +91,11 @@ func (client *CallerSubscriberClient) GetEthBalance(address common.Address) (*as
 
 // CallArgs represents the data used to call the balance method of an ERC
 // contract. "To" is the address of the ERC contract. "Data" is the message sent
-// to the contract.
+// to the contract. "From", if set, is the address the call is made from.
 type CallArgs struct {
-	To   common.Address `json:"to"`
-	Data hexutil.Bytes  `json:"data"`
+	From *common.Address `json:"from,omitempty"`
+	To   common.Address  `json:"to"`
+	Data hexutil.Bytes   `json:"data"`
 }
 
 // GetERC20Balance returns the balance of the given address for the token contract address.
@@ -164,6 +166,28 @@ func (client *CallerSubscriberClient) GetChainID() (*big.Int, error) {
 	return value.ToInt(), err
 }
 
+// estimateGasArgs represents the transaction passed to eth_estimateGas
+type estimateGasArgs struct {
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Data  hexutil.Bytes  `json:"data"`
+	Value *hexutil.Big   `json:"value,omitempty"`
+}
+
+// EstimateGas returns the amount of gas the ethereum node expects the given
+// transaction to use.
+func (client *CallerSubscriberClient) EstimateGas(from, to common.Address, data []byte, value *big.Int) (uint64, error) {
+	var gas hexutil.Uint64
+	args := estimateGasArgs{
+		From:  from,
+		To:    to,
+		Data:  data,
+		Value: (*hexutil.Big)(value),
+	}
+	err := client.Call(&gas, "eth_estimateGas", args)
+	return uint64(gas), err
+}
+
 // SubscribeToLogs registers a subscription for push notifications of logs
 // from a given address.
 //
@@ -0,0 +1,61 @@
+package eth
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const multicallForwarderABI = `[{
+	"name": "forward",
+	"type": "function",
+	"stateMutability": "nonpayable",
+	"inputs": [
+		{"name": "_target", "type": "address"},
+		{"name": "_calls", "type": "bytes[]"}
+	],
+	"outputs": []
+}]`
+
+var (
+	multicallForwarder = mustParseABI(multicallForwarderABI)
+
+	// MulticallForwarderCallResultTopic is the signature of the event the
+	// MulticallForwarder contract emits for each forwarded call.
+	MulticallForwarderCallResultTopic = utils.MustHash("CallResult(uint256,bool)")
+)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// EncodeMulticallForward returns the data of a call to the MulticallForwarder
+// contract relaying each of the given calls to target.
+func EncodeMulticallForward(target common.Address, calls [][]byte) ([]byte, error) {
+	return multicallForwarder.Pack("forward", target, calls)
+}
+
+// MulticallCallSucceeded reports whether the call at the given index of a
+// transaction sent through the MulticallForwarder at forwarder succeeded,
+// according to the CallResult events in the transaction's receipt.
+func MulticallCallSucceeded(receipt TxReceipt, forwarder common.Address, index int) (bool, error) {
+	for _, log := range receipt.Logs {
+		if log.Address != forwarder || len(log.Topics) != 2 || log.Topics[0] != MulticallForwarderCallResultTopic {
+			continue
+		}
+		if log.Topics[1].Big().Cmp(big.NewInt(int64(index))) != 0 {
+			continue
+		}
+		return new(big.Int).SetBytes(log.Data).Sign() != 0, nil
+	}
+	return false, fmt.Errorf("no CallResult event for call #%d in transaction %s", index, receipt.Hash.Hex())
+}
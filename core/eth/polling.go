@@ -0,0 +1,193 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// PollingCallerSubscriber emulates the "newHeads" and "logs" subscriptions
+// over a JSON-RPC endpoint that cannot push notifications, such as HTTP, by
+// polling the ethereum node with eth_blockNumber, eth_getBlockByNumber and
+// eth_getLogs.
+//
+// As with websocket subscriptions, only heads and logs of blocks mined after
+// the subscription was made are sent. Logs of blocks that are reorged out
+// after they were polled are not sent again with Removed set.
+type PollingCallerSubscriber struct {
+	CallerSubscriber
+	interval      time.Duration
+	maxBlockRange uint64
+}
+
+// NewPollingCallerSubscriber returns a CallerSubscriber polling cs every
+// interval for subscriptions, fetching at most maxBlockRange blocks per poll.
+func NewPollingCallerSubscriber(cs CallerSubscriber, interval time.Duration, maxBlockRange uint64) *PollingCallerSubscriber {
+	if maxBlockRange == 0 {
+		maxBlockRange = 1
+	}
+	return &PollingCallerSubscriber{
+		CallerSubscriber: cs,
+		interval:         interval,
+		maxBlockRange:    maxBlockRange,
+	}
+}
+
+// Subscribe starts polling for the "newHeads" or "logs" subscription given
+// in args, sending the results to channel, which must be a chan<- BlockHeader
+// or a chan<- Log respectively.
+func (p *PollingCallerSubscriber) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (Subscription, error) {
+	if len(args) == 0 {
+		return nil, errors.New("missing subscription name")
+	}
+
+	var poll func(from, to uint64, stop <-chan struct{}) error
+	switch args[0] {
+	case "newHeads":
+		ch, ok := channel.(chan<- BlockHeader)
+		if !ok {
+			return nil, fmt.Errorf("newHeads subscription expects a chan<- BlockHeader, got %T", channel)
+		}
+		poll = func(from, to uint64, stop <-chan struct{}) error { return p.pollHeads(ch, from, to, stop) }
+	case "logs":
+		ch, ok := channel.(chan<- Log)
+		if !ok {
+			return nil, fmt.Errorf("logs subscription expects a chan<- Log, got %T", channel)
+		}
+		if len(args) < 2 {
+			return nil, errors.New("logs subscription expects a filter")
+		}
+		filter, ok := args[1].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("logs subscription expects a filter built by utils.ToFilterArg, got %T", args[1])
+		}
+		poll = func(from, to uint64, stop <-chan struct{}) error { return p.pollLogs(ch, filter, from, to, stop) }
+	default:
+		return nil, fmt.Errorf("cannot poll for %v subscription", args[0])
+	}
+
+	latest, err := p.blockNumber()
+	if err != nil {
+		return nil, err
+	}
+	sub := &pollingSubscription{
+		chErr:  make(chan error, 1),
+		chStop: make(chan struct{}),
+	}
+	go sub.run(p.interval, latest, p.maxBlockRange, p.blockNumber, poll)
+	return sub, nil
+}
+
+func (p *PollingCallerSubscriber) blockNumber() (uint64, error) {
+	var number hexutil.Uint64
+	err := p.Call(&number, "eth_blockNumber")
+	return uint64(number), err
+}
+
+func (p *PollingCallerSubscriber) pollHeads(ch chan<- BlockHeader, from, to uint64, stop <-chan struct{}) error {
+	for number := from; number <= to; number++ {
+		var header BlockHeader
+		err := p.Call(&header, "eth_getBlockByNumber", hexutil.EncodeUint64(number), false)
+		if err != nil {
+			return err
+		}
+		select {
+		case ch <- header:
+		case <-stop:
+			return nil
+		}
+	}
+	return nil
+}
+
+func (p *PollingCallerSubscriber) pollLogs(ch chan<- Log, filter map[string]interface{}, from, to uint64, stop <-chan struct{}) error {
+	q := make(map[string]interface{}, len(filter))
+	for key, value := range filter {
+		q[key] = value
+	}
+	q["fromBlock"] = hexutil.EncodeUint64(from)
+	q["toBlock"] = hexutil.EncodeUint64(to)
+	if addresses, ok := q["address"].([]common.Address); ok && len(addresses) == 0 {
+		delete(q, "address")
+	}
+
+	var logs []Log
+	if err := p.Call(&logs, "eth_getLogs", q); err != nil {
+		return err
+	}
+	for _, log := range logs {
+		select {
+		case ch <- log:
+		case <-stop:
+			return nil
+		}
+	}
+	return nil
+}
+
+type pollingSubscription struct {
+	chErr    chan error
+	chStop   chan struct{}
+	stopOnce sync.Once
+}
+
+// run polls every interval for the blocks mined since the last poll, in
+// ranges of at most maxBlockRange blocks. It stops on the first error, which
+// is sent on the Err channel like a dropped websocket subscription.
+func (s *pollingSubscription) run(
+	interval time.Duration,
+	latest uint64,
+	maxBlockRange uint64,
+	blockNumber func() (uint64, error),
+	poll func(from, to uint64, stop <-chan struct{}) error,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case <-ticker.C:
+		}
+
+		head, err := blockNumber()
+		if err != nil {
+			s.chErr <- err
+			return
+		}
+		for latest < head {
+			to := latest + maxBlockRange
+			if to > head {
+				to = head
+			}
+			if err := poll(latest+1, to, s.chStop); err != nil {
+				s.chErr <- err
+				return
+			}
+			latest = to
+
+			select {
+			case <-s.chStop:
+				return
+			default:
+			}
+		}
+	}
+}
+
+func (s *pollingSubscription) Err() <-chan error {
+	return s.chErr
+}
+
+// Unsubscribe stops polling. It can be called any number of times.
+func (s *pollingSubscription) Unsubscribe() {
+	s.stopOnce.Do(func() {
+		close(s.chStop)
+	})
+}
@@ -0,0 +1,115 @@
+package eth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockBlockNumber(cs *mocks.CallerSubscriber, number uint64) *mock.Call {
+	return cs.On("Call", mock.Anything, "eth_blockNumber").
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*hexutil.Uint64) = hexutil.Uint64(number)
+		}).
+		Return(nil).
+		Once()
+}
+
+func TestPollingCallerSubscriber_Logs(t *testing.T) {
+	t.Parallel()
+
+	address := cltest.NewAddress()
+	cs := new(mocks.CallerSubscriber)
+	mockBlockNumber(cs, 10)
+	mockBlockNumber(cs, 13)
+	cs.On("Call", mock.Anything, "eth_getLogs", mock.MatchedBy(func(q map[string]interface{}) bool {
+		return q["fromBlock"] == "0xb" && q["toBlock"] == "0xd"
+	})).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]eth.Log) = []eth.Log{{Address: address, BlockNumber: 12}}
+		}).
+		Return(nil).
+		Once()
+	cs.On("Call", mock.Anything, "eth_blockNumber").
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*hexutil.Uint64) = hexutil.Uint64(13)
+		}).
+		Return(nil)
+
+	poller := eth.NewPollingCallerSubscriber(cs, 10*time.Millisecond, 100)
+	client := &eth.CallerSubscriberClient{CallerSubscriber: poller}
+
+	logs := make(chan eth.Log)
+	sub, err := client.SubscribeToLogs(context.Background(), logs, ethereum.FilterQuery{
+		Addresses: []common.Address{address},
+	})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	select {
+	case log := <-logs:
+		assert.Equal(t, address, log.Address)
+		assert.Equal(t, uint64(12), log.BlockNumber)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for polled log")
+	}
+}
+
+func TestPollingCallerSubscriber_NewHeads_InBlockRanges(t *testing.T) {
+	t.Parallel()
+
+	cs := new(mocks.CallerSubscriber)
+	mockBlockNumber(cs, 10)
+	mockBlockNumber(cs, 13)
+	for _, number := range []string{"0xb", "0xc", "0xd"} {
+		number := number
+		cs.On("Call", mock.Anything, "eth_getBlockByNumber", number, false).
+			Run(func(args mock.Arguments) {
+				header := args.Get(0).(*eth.BlockHeader)
+				header.Number = hexutil.Big(*hexutil.MustDecodeBig(number))
+			}).
+			Return(nil).
+			Once()
+	}
+	cs.On("Call", mock.Anything, "eth_blockNumber").
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*hexutil.Uint64) = hexutil.Uint64(13)
+		}).
+		Return(nil)
+
+	poller := eth.NewPollingCallerSubscriber(cs, 10*time.Millisecond, 2)
+	client := &eth.CallerSubscriberClient{CallerSubscriber: poller}
+
+	heads := make(chan eth.BlockHeader)
+	sub, err := client.SubscribeToNewHeads(context.Background(), heads)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	for _, expected := range []int64{11, 12, 13} {
+		select {
+		case head := <-heads:
+			assert.Equal(t, expected, head.Number.ToInt().Int64())
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for polled head")
+		}
+	}
+}
+
+func TestPollingCallerSubscriber_UnknownSubscription(t *testing.T) {
+	t.Parallel()
+
+	poller := eth.NewPollingCallerSubscriber(new(mocks.CallerSubscriber), time.Second, 100)
+	_, err := poller.Subscribe(context.Background(), make(chan<- eth.Log), "newPendingTransactions")
+	assert.Error(t, err)
+}
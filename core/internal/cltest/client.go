@@ -70,6 +70,9 @@ func (c *SimulatedBackendClient) Call(result interface{}, method string,
 			return err
 		}
 		callMsg := ethereum.CallMsg{To: &callArgs.To, Data: callArgs.Data}
+		if callArgs.From != nil {
+			callMsg.From = *callArgs.From
+		}
 		b, err := c.b.CallContract(context.TODO(), callMsg, nil /* always latest block */)
 		if err != nil {
 			return errors.Wrapf(err, "while calling contract at address %x with "+
@@ -87,6 +90,27 @@ func (c *SimulatedBackendClient) Call(result interface{}, method string,
 			return fmt.Errorf("first arg to SimulatedBackendClient.Call is an "+
 				"unrecognized type: %T; add processing logic for it here", result)
 		}
+	case "eth_getCode":
+		if len(args) != 2 {
+			return fmt.Errorf(
+				"should have two arguments after \"eth_getCode\", got %d", len(args))
+		}
+		address, ok := args[0].(common.Address)
+		if !ok {
+			return fmt.Errorf("third arg to SimulatedBackendClient.Call must be "+
+				"a common.Address, got %+#v", args[0])
+		}
+		code, err := c.b.CodeAt(context.TODO(), address, nil /* always latest block */)
+		if err != nil {
+			return errors.Wrapf(err, "while fetching code at address %x", address)
+		}
+		r, ok := result.(*hexutil.Bytes)
+		if !ok {
+			return fmt.Errorf("first arg to SimulatedBackendClient.Call is an "+
+				"unrecognized type: %T; add processing logic for it here", result)
+		}
+		*r = code
+		return nil
 	default:
 		return fmt.Errorf("second arg to SimulatedBackendClient.Call is an RPC "+
 			"API method which has not yet been implemented: %s. Add processing for "+
@@ -295,6 +319,18 @@ func (c *SimulatedBackendClient) GetChainID() (*big.Int, error) {
 	return big.NewInt(int64(c.chainId)), nil
 }
 
+// EstimateGas returns the gas the simulated backend expects the transaction
+// to use.
+func (c *SimulatedBackendClient) EstimateGas(from, to common.Address, data []byte,
+	value *big.Int) (uint64, error) {
+	return c.b.EstimateGas(context.TODO(), ethereum.CallMsg{
+		From:  from,
+		To:    &to,
+		Data:  data,
+		Value: value,
+	})
+}
+
 // SubscribeToNewHeads registers a subscription for push notifications of new
 // blocks.
 func (c *SimulatedBackendClient) SubscribeToNewHeads(ctx context.Context,
@@ -162,7 +162,9 @@ func NewTestConfig(t testing.TB, options ...interface{}) *TestConfig {
 	rawConfig.Set("BRIDGE_RESPONSE_URL", "http://localhost:6688")
 	rawConfig.Set("ETH_CHAIN_ID", 3)
 	rawConfig.Set("CHAINLINK_DEV", true)
+	rawConfig.Set("BALANCE_MONITOR_ENABLED", false)
 	rawConfig.Set("ETH_GAS_BUMP_THRESHOLD", 3)
+	rawConfig.Set("ETH_GAS_ESTIMATION_ENABLED", false)
 	rawConfig.Set("MIGRATE_DATABASE", false)
 	rawConfig.Set("MINIMUM_SERVICE_DURATION", "24h")
 	rawConfig.Set("MIN_INCOMING_CONFIRMATIONS", 1)
@@ -1022,6 +1024,14 @@ func Head(val interface{}) *models.Head {
 	}
 }
 
+// DefaultChainHead given the value convert it into a Head on the store's
+// default chain
+func DefaultChainHead(store *strpkg.Store, val interface{}) *models.Head {
+	h := Head(val)
+	h.ChainID = utils.NewBig(store.Config.ChainID())
+	return h
+}
+
 // EmptyBlock returns a new empty ethereum block
 func EmptyBlock() eth.Block {
 	return eth.Block{}
@@ -654,6 +654,8 @@ type MockHeadTrackable struct {
 	ConnectedCallback func(bn *models.Head)
 	disconnectedCount int32
 	onNewHeadCount    int32
+	reorgsMutex       sync.Mutex
+	reorgs            []store.Reorg
 }
 
 // Connect increases the connected count by one
@@ -686,6 +688,20 @@ func (m *MockHeadTrackable) OnNewHeadCount() int32 {
 	return atomic.LoadInt32(&m.onNewHeadCount)
 }
 
+// OnReorg records the reorganisation
+func (m *MockHeadTrackable) OnReorg(reorg store.Reorg) {
+	m.reorgsMutex.Lock()
+	defer m.reorgsMutex.Unlock()
+	m.reorgs = append(m.reorgs, reorg)
+}
+
+// Reorgs returns the reorganisations seen so far, safely.
+func (m *MockHeadTrackable) Reorgs() []store.Reorg {
+	m.reorgsMutex.Lock()
+	defer m.reorgsMutex.Unlock()
+	return append([]store.Reorg{}, m.reorgs...)
+}
+
 // NeverSleeper is a struct that never sleeps
 type NeverSleeper struct{}
 
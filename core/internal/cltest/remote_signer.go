@@ -0,0 +1,172 @@
+package cltest
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// RemoteSigner is a Clef compatible remote signer keeping its keys in memory,
+// for testing the RemoteKeyStore.
+type RemoteSigner struct {
+	URL     string
+	ChainID *big.Int
+
+	mutex     sync.Mutex
+	addresses []common.Address
+	keys      map[common.Address]*ecdsa.PrivateKey
+}
+
+// NewRemoteSigner starts a mock signer holding one new key, serving the
+// JSON-RPC API over HTTP at its URL until cleanup is called.
+func NewRemoteSigner(t testing.TB) (*RemoteSigner, func()) {
+	t.Helper()
+
+	signer := &RemoteSigner{
+		ChainID: big.NewInt(3),
+		keys:    make(map[common.Address]*ecdsa.PrivateKey),
+	}
+	signer.AddKey(t)
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("account", &remoteSignerAPI{signer}))
+	httpServer := httptest.NewServer(server)
+	signer.URL = httpServer.URL
+	return signer, func() {
+		httpServer.Close()
+		server.Stop()
+	}
+}
+
+// AddKey creates a new key in the signer and returns its address.
+func (s *RemoteSigner) AddKey(t testing.TB) common.Address {
+	t.Helper()
+	address, err := s.newKey()
+	require.NoError(t, err)
+	return address
+}
+
+// Addresses returns the addresses of the keys held by the signer.
+func (s *RemoteSigner) Addresses() []common.Address {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]common.Address{}, s.addresses...)
+}
+
+func (s *RemoteSigner) newKey() (common.Address, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.addresses = append(s.addresses, address)
+	s.keys[address] = key
+	return address, nil
+}
+
+func (s *RemoteSigner) key(address common.Address) (*ecdsa.PrivateKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	key, ok := s.keys[address]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %s", address.Hex())
+	}
+	return key, nil
+}
+
+// remoteSignerAPI holds the methods of the "account" JSON-RPC namespace.
+type remoteSignerAPI struct {
+	signer *RemoteSigner
+}
+
+type remoteSignerTxArgs struct {
+	From     common.MixedcaseAddress  `json:"from"`
+	To       *common.MixedcaseAddress `json:"to"`
+	Gas      hexutil.Uint64           `json:"gas"`
+	GasPrice hexutil.Big              `json:"gasPrice"`
+	Value    hexutil.Big              `json:"value"`
+	Nonce    hexutil.Uint64           `json:"nonce"`
+	Data     *hexutil.Bytes           `json:"data"`
+	ChainID  *hexutil.Big             `json:"chainId"`
+}
+
+type remoteSignerTxResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+func (api *remoteSignerAPI) Version() (string, error) {
+	return "6.0.0", nil
+}
+
+func (api *remoteSignerAPI) List() ([]common.Address, error) {
+	return api.signer.Addresses(), nil
+}
+
+func (api *remoteSignerAPI) New() (common.Address, error) {
+	return api.signer.newKey()
+}
+
+func (api *remoteSignerAPI) SignTransaction(args remoteSignerTxArgs) (*remoteSignerTxResult, error) {
+	key, err := api.signer.key(args.From.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
+	var tx *types.Transaction
+	if args.To == nil {
+		tx = types.NewContractCreation(uint64(args.Nonce), args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), data)
+	} else {
+		tx = types.NewTransaction(uint64(args.Nonce), args.To.Address(), args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), data)
+	}
+	chainID := api.signer.ChainID
+	if args.ChainID != nil {
+		chainID = args.ChainID.ToInt()
+	}
+
+	signed, err := types.SignTx(tx, types.NewEIP155Signer(chainID), key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := rlp.EncodeToBytes(signed)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignerTxResult{Raw: raw, Tx: signed}, nil
+}
+
+func (api *remoteSignerAPI) SignData(contentType string, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if contentType != "text/plain" {
+		return nil, errors.New("remote signer only signs text/plain data")
+	}
+	key, err := api.signer.key(addr.Address())
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(accounts.TextHash(data), key)
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+	return signature, nil
+}
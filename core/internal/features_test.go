@@ -160,7 +160,7 @@ func TestIntegration_FeeBump(t *testing.T) {
 		eth.Register("eth_chainId", config.ChainID())
 		eth.Register("eth_getTransactionCount", `0x0100`) // TxManager.ActivateAccount()
 	})
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(firstTxSentAt)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, firstTxSentAt)))
 	assert.NoError(t, app.Start())
 	eth.EventuallyAllCalled(t)
 
@@ -312,7 +312,7 @@ func TestIntegration_RunLog(t *testing.T) {
 			name:             "ommered request",
 			logBlockHash:     triggeringBlockHash,
 			receiptBlockHash: otherBlockHash,
-			wantStatus:       models.RunStatusErrored,
+			wantStatus:       models.RunStatusCancelled,
 		},
 	}
 
@@ -626,7 +626,7 @@ func TestIntegration_NonceManagement_firstRunWithExistingTxs(t *testing.T) {
 		eth.RegisterSubscription("newHeads", newHeads)
 		eth.Register("eth_getTransactionCount", `0x100`) // activate account nonce
 	})
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(100)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, 100)))
 	require.NoError(t, app.StartAndConnect())
 
 	j := cltest.FixtureCreateJobViaWeb(t, app, "fixtures/web/web_initiated_eth_tx_job.json")
@@ -889,6 +889,12 @@ func TestIntegration_FluxMonitor_Deviation(t *testing.T) {
 	require.NoError(t, app.StartAndConnect())
 	eth.EventuallyAllCalled(t)
 
+	// The job is created without an aggregatorVersion: the probe for
+	// whitelistEnabled() reverts, so it is detected as a FluxAggregator.
+	eth.Context("Job creation detects the aggregator version", func(mock *cltest.EthMock) {
+		mock.RegisterError("eth_call", "execution reverted")
+	})
+
 	// Configure fake Eth Node to return 10,000 cents when FM initiates price.
 	eth.Context("Flux Monitor initializes price", func(mock *cltest.EthMock) {
 		hex := cltest.MakeRoundStateReturnData(2, true, 10000, 7, 0, availableFunds, minPayment, 1)
@@ -967,6 +973,12 @@ func TestIntegration_FluxMonitor_NewRound(t *testing.T) {
 	require.NoError(t, app.StartAndConnect())
 	eth.EventuallyAllCalled(t)
 
+	// The job is created without an aggregatorVersion: the probe for
+	// whitelistEnabled() reverts, so it is detected as a FluxAggregator.
+	eth.Context("Job creation detects the aggregator version", func(mock *cltest.EthMock) {
+		mock.RegisterError("eth_call", "execution reverted")
+	})
+
 	// Configure fake Eth Node to return 10,000 cents when FM initiates price.
 	eth.Context("Flux Monitor queries FluxAggregator.RoundState()", func(mock *cltest.EthMock) {
 		hex := cltest.MakeRoundStateReturnData(2, true, 10000, 7, 0, availableFunds, minPayment, 1)
@@ -1138,7 +1150,7 @@ func TestIntegration_EthTX_Reconnect(t *testing.T) {
 	startHeight := 100
 	eth.RegisterSubscription("newHeads", newHeads)
 	eth.Register("eth_getTransactionCount", `0x100`)
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(startHeight)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, startHeight)))
 	require.NoError(t, app.StartAndConnect())
 
 	j := cltest.FixtureCreateJobViaWeb(t, app, "fixtures/web/web_initiated_eth_tx_job.json")
@@ -11,6 +11,8 @@ import (
 
 	packr "github.com/gobuffalo/packr"
 
+	services "github.com/smartcontractkit/chainlink/core/services"
+
 	store "github.com/smartcontractkit/chainlink/core/store"
 
 	synchronization "github.com/smartcontractkit/chainlink/core/services/synchronization"
@@ -132,6 +134,22 @@ func (_m *Application) CreateErrored(jobSpecID *models.ID, initiator models.Init
 	return r0, r1
 }
 
+// GetBalanceMonitor provides a mock function with given fields: chainID
+func (_m *Application) GetBalanceMonitor(chainID *big.Int) services.BalanceMonitor {
+	ret := _m.Called(chainID)
+
+	var r0 services.BalanceMonitor
+	if rf, ok := ret.Get(0).(func(*big.Int) services.BalanceMonitor); ok {
+		r0 = rf(chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(services.BalanceMonitor)
+		}
+	}
+
+	return r0
+}
+
 // GetStatsPusher provides a mock function with given fields:
 func (_m *Application) GetStatsPusher() synchronization.StatsPusher {
 	ret := _m.Called()
@@ -178,13 +196,27 @@ func (_m *Application) NewBox() packr.Box {
 	return r0
 }
 
-// ResumeAllPendingNextBlock provides a mock function with given fields: currentBlockHeight
-func (_m *Application) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error {
-	ret := _m.Called(currentBlockHeight)
+// PauseFluxMonitorJob provides a mock function with given fields: _a0
+func (_m *Application) PauseFluxMonitorJob(_a0 *models.ID) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeAllPendingNextBlock provides a mock function with given fields: chainID, currentBlockHeight
+func (_m *Application) ResumeAllPendingNextBlock(chainID *big.Int, currentBlockHeight *big.Int) error {
+	ret := _m.Called(chainID, currentBlockHeight)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(currentBlockHeight)
+	if rf, ok := ret.Get(0).(func(*big.Int, *big.Int) error); ok {
+		r0 = rf(chainID, currentBlockHeight)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -220,6 +252,20 @@ func (_m *Application) ResumeAllInProgress() error {
 	return r0
 }
 
+// ResumeFluxMonitorJob provides a mock function with given fields: _a0
+func (_m *Application) ResumeFluxMonitorJob(_a0 *models.ID) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumePendingBridge provides a mock function with given fields: runID, input
 func (_m *Application) ResumePendingBridge(runID *models.ID, input models.BridgeRunResult) error {
 	ret := _m.Called(runID, input)
@@ -40,6 +40,27 @@ func (_m *Client) Call(result interface{}, method string, args ...interface{}) e
 	return r0
 }
 
+// EstimateGas provides a mock function with given fields: from, to, data, value
+func (_m *Client) EstimateGas(from common.Address, to common.Address, data []byte, value *big.Int) (uint64, error) {
+	ret := _m.Called(from, to, data, value)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address, []byte, *big.Int) uint64); ok {
+		r0 = rf(from, to, data, value)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, common.Address, []byte, *big.Int) error); ok {
+		r1 = rf(from, to, data, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockByNumber provides a mock function with given fields: hex
 func (_m *Client) GetBlockByNumber(hex string) (eth.Block, error) {
 	ret := _m.Called(hex)
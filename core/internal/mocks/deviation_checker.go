@@ -9,6 +9,16 @@ type DeviationChecker struct {
 	mock.Mock
 }
 
+// Pause provides a mock function with given fields:
+func (_m *DeviationChecker) Pause() {
+	_m.Called()
+}
+
+// Resume provides a mock function with given fields:
+func (_m *DeviationChecker) Resume() {
+	_m.Called()
+}
+
 // Start provides a mock function with given fields:
 func (_m *DeviationChecker) Start() {
 	_m.Called()
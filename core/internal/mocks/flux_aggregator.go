@@ -4,6 +4,8 @@ package mocks
 
 import (
 	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	contracts "github.com/smartcontractkit/chainlink/core/services/eth/contracts"
@@ -102,6 +104,73 @@ func (_m *FluxAggregator) GetMethodID(method string) ([]byte, error) {
 	return r0, r1
 }
 
+// LatestAnswer provides a mock function with given fields:
+func (_m *FluxAggregator) LatestAnswer() (*big.Int, error) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LatestRoundData provides a mock function with given fields:
+func (_m *FluxAggregator) LatestRoundData() (contracts.FluxAggregatorRoundData, error) {
+	ret := _m.Called()
+
+	var r0 contracts.FluxAggregatorRoundData
+	if rf, ok := ret.Get(0).(func() contracts.FluxAggregatorRoundData); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(contracts.FluxAggregatorRoundData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Oracles provides a mock function with given fields:
+func (_m *FluxAggregator) Oracles() ([]common.Address, error) {
+	ret := _m.Called()
+
+	var r0 []common.Address
+	if rf, ok := ret.Get(0).(func() []common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RoundState provides a mock function with given fields: oracle
 func (_m *FluxAggregator) RoundState(oracle common.Address) (contracts.FluxAggregatorRoundState, error) {
 	ret := _m.Called(oracle)
@@ -146,6 +215,29 @@ func (_m *FluxAggregator) SubscribeToLogs(listener eth.LogListener) (bool, eth.U
 	return r0, r1
 }
 
+// SubscribeToSubmissions provides a mock function with given fields: listener
+func (_m *FluxAggregator) SubscribeToSubmissions(listener eth.LogListener) (bool, eth.UnsubscribeFunc) {
+	ret := _m.Called(listener)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(eth.LogListener) bool); ok {
+		r0 = rf(listener)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 eth.UnsubscribeFunc
+	if rf, ok := ret.Get(1).(func(eth.LogListener) eth.UnsubscribeFunc); ok {
+		r1 = rf(listener)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(eth.UnsubscribeFunc)
+		}
+	}
+
+	return r0, r1
+}
+
 // UnpackLog provides a mock function with given fields: out, event, log
 func (_m *FluxAggregator) UnpackLog(out interface{}, event string, log coreeth.Log) error {
 	ret := _m.Called(out, event, log)
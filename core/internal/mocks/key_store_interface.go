@@ -37,6 +37,43 @@ func (_m *KeyStoreInterface) Accounts() []accounts.Account {
 	return r0
 }
 
+// Delete provides a mock function with given fields: a, passphrase
+func (_m *KeyStoreInterface) Delete(a accounts.Account, passphrase string) error {
+	ret := _m.Called(a, passphrase)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(accounts.Account, string) error); ok {
+		r0 = rf(a, passphrase)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Export provides a mock function with given fields: a, passphrase, newPassphrase
+func (_m *KeyStoreInterface) Export(a accounts.Account, passphrase string, newPassphrase string) ([]byte, error) {
+	ret := _m.Called(a, passphrase, newPassphrase)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(accounts.Account, string, string) []byte); ok {
+		r0 = rf(a, passphrase, newPassphrase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(accounts.Account, string, string) error); ok {
+		r1 = rf(a, passphrase, newPassphrase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAccounts provides a mock function with given fields:
 func (_m *KeyStoreInterface) GetAccounts() []accounts.Account {
 	ret := _m.Called()
@@ -39,13 +39,20 @@ func (_m *LogBroadcaster) DependentReady() {
 	_m.Called()
 }
 
-// Register provides a mock function with given fields: address, listener
-func (_m *LogBroadcaster) Register(address common.Address, listener eth.LogListener) bool {
-	ret := _m.Called(address, listener)
+// Register provides a mock function with given fields: address, listener, topics
+func (_m *LogBroadcaster) Register(address common.Address, listener eth.LogListener, topics ...common.Hash) bool {
+	_va := make([]interface{}, len(topics))
+	for _i := range topics {
+		_va[_i] = topics[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, listener)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(common.Address, eth.LogListener) bool); ok {
-		r0 = rf(address, listener)
+	if rf, ok := ret.Get(0).(func(common.Address, eth.LogListener, ...common.Hash) bool); ok {
+		r0 = rf(address, listener, topics...)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
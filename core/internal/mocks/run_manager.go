@@ -111,13 +111,13 @@ func (_m *RunManager) ResumeAllPendingConnection() error {
 	return r0
 }
 
-// ResumeAllPendingNextBlock provides a mock function with given fields: currentBlockHeight
-func (_m *RunManager) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error {
-	ret := _m.Called(currentBlockHeight)
+// ResumeAllPendingNextBlock provides a mock function with given fields: chainID, currentBlockHeight
+func (_m *RunManager) ResumeAllPendingNextBlock(chainID *big.Int, currentBlockHeight *big.Int) error {
+	ret := _m.Called(chainID, currentBlockHeight)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(currentBlockHeight)
+	if rf, ok := ret.Get(0).(func(*big.Int, *big.Int) error); ok {
+		r0 = rf(chainID, currentBlockHeight)
 	} else {
 		r0 = ret.Error(0)
 	}
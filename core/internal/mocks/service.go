@@ -26,11 +26,21 @@ func (_m *Service) AddJob(_a0 models.JobSpec) error {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: _a0
+func (_m *Service) PauseJob(_a0 *models.ID) {
+	_m.Called(_a0)
+}
+
 // RemoveJob provides a mock function with given fields: _a0
 func (_m *Service) RemoveJob(_a0 *models.ID) {
 	_m.Called(_a0)
 }
 
+// ResumeJob provides a mock function with given fields: _a0
+func (_m *Service) ResumeJob(_a0 *models.ID) {
+	_m.Called(_a0)
+}
+
 // Start provides a mock function with given fields:
 func (_m *Service) Start() error {
 	ret := _m.Called()
@@ -30,6 +30,20 @@ type TxManager struct {
 	mock.Mock
 }
 
+// BatchingEnabled provides a mock function with given fields:
+func (_m *TxManager) BatchingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // BumpGasUntilSafe provides a mock function with given fields: hash
 func (_m *TxManager) BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, store.AttemptState, error) {
 	ret := _m.Called(hash)
@@ -77,6 +91,20 @@ func (_m *TxManager) Call(result interface{}, method string, args ...interface{}
 	return r0
 }
 
+// CancelTx provides a mock function with given fields: tx
+func (_m *TxManager) CancelTx(tx *models.Tx) error {
+	ret := _m.Called(tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Tx) error); ok {
+		r0 = rf(tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CheckAttempt provides a mock function with given fields: txAttempt, blockHeight
 func (_m *TxManager) CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, store.AttemptState, error) {
 	ret := _m.Called(txAttempt, blockHeight)
@@ -156,6 +184,27 @@ func (_m *TxManager) ContractLINKBalance(wr models.WithdrawalRequest) (assets.Li
 	return r0, r1
 }
 
+// CreateBatchedTx provides a mock function with given fields: jobRunID, to, data, gasLimit
+func (_m *TxManager) CreateBatchedTx(jobRunID *models.ID, to common.Address, data []byte, gasLimit uint64) (int, error) {
+	ret := _m.Called(jobRunID, to, data, gasLimit)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(*models.ID, common.Address, []byte, uint64) int); ok {
+		r0 = rf(jobRunID, to, data, gasLimit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.ID, common.Address, []byte, uint64) error); ok {
+		r1 = rf(jobRunID, to, data, gasLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTx provides a mock function with given fields: to, data
 func (_m *TxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error) {
 	ret := _m.Called(to, data)
@@ -225,11 +274,55 @@ func (_m *TxManager) CreateTxWithGas(surrogateID null.String, to common.Address,
 	return r0, r1
 }
 
+// CreateTxWithGasFrom provides a mock function with given fields: surrogateID, from, to, data, gasPriceWei, gasLimit
+func (_m *TxManager) CreateTxWithGasFrom(surrogateID null.String, from common.Address, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+	ret := _m.Called(surrogateID, from, to, data, gasPriceWei, gasLimit)
+
+	var r0 *models.Tx
+	if rf, ok := ret.Get(0).(func(null.String, common.Address, common.Address, []byte, *big.Int, uint64) *models.Tx); ok {
+		r0 = rf(surrogateID, from, to, data, gasPriceWei, gasLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Tx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(null.String, common.Address, common.Address, []byte, *big.Int, uint64) error); ok {
+		r1 = rf(surrogateID, from, to, data, gasPriceWei, gasLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Disconnect provides a mock function with given fields:
 func (_m *TxManager) Disconnect() {
 	_m.Called()
 }
 
+// EstimateGas provides a mock function with given fields: from, to, data, value
+func (_m *TxManager) EstimateGas(from common.Address, to common.Address, data []byte, value *big.Int) (uint64, error) {
+	ret := _m.Called(from, to, data, value)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address, []byte, *big.Int) uint64); ok {
+		r0 = rf(from, to, data, value)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, common.Address, []byte, *big.Int) error); ok {
+		r1 = rf(from, to, data, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockByNumber provides a mock function with given fields: hex
 func (_m *TxManager) GetBlockByNumber(hex string) (eth.Block, error) {
 	ret := _m.Called(hex)
@@ -478,6 +571,11 @@ func (_m *TxManager) Register(_a0 []accounts.Account) {
 	_m.Called(_a0)
 }
 
+// Retire provides a mock function with given fields: _a0
+func (_m *TxManager) Retire(_a0 []accounts.Account) {
+	_m.Called(_a0)
+}
+
 // SendRawTx provides a mock function with given fields: bytes
 func (_m *TxManager) SendRawTx(bytes []byte) (common.Hash, error) {
 	ret := _m.Called(bytes)
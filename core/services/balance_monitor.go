@@ -0,0 +1,227 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var promLowBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "balance_monitor_low_balance",
+	Help: "1 if the account's balance of the asset is below its alert threshold, 0 otherwise",
+},
+	[]string{"account", "asset", "chain_id"},
+)
+
+// The assets whose balances are monitored
+const (
+	AssetETH  = "ETH"
+	AssetLINK = "LINK"
+)
+
+// BalanceMonitor checks the ETH and LINK balances of the node's accounts on
+// every new head of its chain, and alerts when they drop below the configured
+// thresholds. Checks cost two calls to the ethereum node per account and run
+// in the background, skipping heads that arrive while one is in progress.
+type BalanceMonitor interface {
+	store.HeadTrackable
+	Stop() error
+	EthBalance(address common.Address) *assets.Eth
+	LinkBalance(address common.Address) *assets.Link
+}
+
+// LowBalanceAlert is POSTed to BALANCE_MONITOR_ALERT_URL when the balance of
+// an account drops below its threshold. Amounts are in Wei and Juels.
+type LowBalanceAlert struct {
+	Address   common.Address `json:"address"`
+	ChainID   *utils.Big     `json:"chainId"`
+	Asset     string         `json:"asset"`
+	Balance   string         `json:"balance"`
+	Threshold string         `json:"threshold"`
+}
+
+type balanceMonitor struct {
+	store        *store.Store
+	chainID      *big.Int
+	sleeper      SleeperTask
+	mutex        sync.RWMutex
+	ethBalances  map[common.Address]*assets.Eth
+	linkBalances map[common.Address]*assets.Link
+	low          map[string]bool
+}
+
+// NewBalanceMonitor returns a new balance monitor for the accounts of the
+// default chain.
+func NewBalanceMonitor(store *store.Store) BalanceMonitor {
+	return NewChainBalanceMonitor(store, nil)
+}
+
+// NewChainBalanceMonitor returns a new balance monitor for the accounts of the
+// given chain.
+func NewChainBalanceMonitor(store *store.Store, chainID *big.Int) BalanceMonitor {
+	bm := &balanceMonitor{
+		store:        store,
+		chainID:      chainID,
+		ethBalances:  make(map[common.Address]*assets.Eth),
+		linkBalances: make(map[common.Address]*assets.Link),
+		low:          make(map[string]bool),
+	}
+	bm.sleeper = NewSleeperTask(bm)
+	return bm
+}
+
+func (bm *balanceMonitor) chain() (store.Chain, error) {
+	return resolveChain(bm.store, bm.chainID)
+}
+
+// Connect starts checking the balances in the background, so that they are
+// known before the first head arrives.
+func (bm *balanceMonitor) Connect(*models.Head) error {
+	chain, err := bm.chain()
+	if err != nil {
+		return err
+	}
+	if chain.Config.BalanceMonitorEnabled() {
+		bm.sleeper.WakeUp()
+	}
+	return nil
+}
+
+func (bm *balanceMonitor) Disconnect() {
+}
+
+// OnNewHead checks the balances in the background, skipping heads that
+// arrive while a check is still running.
+func (bm *balanceMonitor) OnNewHead(*models.Head) {
+	chain, err := bm.chain()
+	if err != nil {
+		logger.Error("BalanceMonitor: ", err)
+		return
+	}
+	if chain.Config.BalanceMonitorEnabled() {
+		bm.sleeper.WakeUp()
+	}
+}
+
+// Stop waits for any balance check in progress to finish.
+func (bm *balanceMonitor) Stop() error {
+	return bm.sleeper.Stop()
+}
+
+// EthBalance returns the last known ETH balance of the account, or nil.
+func (bm *balanceMonitor) EthBalance(address common.Address) *assets.Eth {
+	bm.mutex.RLock()
+	defer bm.mutex.RUnlock()
+	return bm.ethBalances[address]
+}
+
+// LinkBalance returns the last known LINK balance of the account, or nil.
+func (bm *balanceMonitor) LinkBalance(address common.Address) *assets.Link {
+	bm.mutex.RLock()
+	defer bm.mutex.RUnlock()
+	return bm.linkBalances[address]
+}
+
+// Work fetches the balances of every account of the key store on the chain.
+func (bm *balanceMonitor) Work() {
+	chain, err := bm.chain()
+	if err != nil {
+		logger.Error("BalanceMonitor: ", err)
+		return
+	}
+	config := chain.Config
+	for _, account := range bm.store.KeyStore.Accounts() {
+		ethBalance, err := chain.TxManager.GetEthBalance(account.Address)
+		if err != nil {
+			logger.Errorw("BalanceMonitor: error getting ETH balance", "address", account.Address.Hex(), "chainID", chain.ID, "error", err)
+			continue
+		}
+		linkBalance, err := chain.TxManager.GetLINKBalance(account.Address)
+		if err != nil {
+			logger.Errorw("BalanceMonitor: error getting LINK balance", "address", account.Address.Hex(), "chainID", chain.ID, "error", err)
+			continue
+		}
+
+		bm.mutex.Lock()
+		bm.ethBalances[account.Address] = ethBalance
+		bm.linkBalances[account.Address] = linkBalance
+		bm.mutex.Unlock()
+
+		store.PromUpdateBalances(chain.ID, account.Address, ethBalance, linkBalance)
+		bm.checkThreshold(chain, account.Address, AssetETH, ethBalance.ToInt(), config.BalanceMonitorEthThreshold().ToInt())
+		bm.checkThreshold(chain, account.Address, AssetLINK, linkBalance.ToInt(), config.BalanceMonitorLinkThreshold().ToInt())
+	}
+}
+
+// checkThreshold alerts once when the balance drops below the threshold, and
+// again only after it has been topped up and dropped once more. A zero
+// threshold disables alerts for the asset.
+func (bm *balanceMonitor) checkThreshold(chain store.Chain, address common.Address, asset string, balance, threshold *big.Int) {
+	low := threshold.Sign() > 0 && balance.Cmp(threshold) < 0
+	if low {
+		promLowBalance.WithLabelValues(address.Hex(), asset, chain.ID.String()).Set(1)
+	} else {
+		promLowBalance.WithLabelValues(address.Hex(), asset, chain.ID.String()).Set(0)
+	}
+
+	key := fmt.Sprintf("%s/%s", address.Hex(), asset)
+	bm.mutex.Lock()
+	wasLow := bm.low[key]
+	bm.low[key] = low
+	bm.mutex.Unlock()
+
+	if low && !wasLow {
+		bm.alert(chain, LowBalanceAlert{
+			Address:   address,
+			ChainID:   utils.NewBig(chain.ID),
+			Asset:     asset,
+			Balance:   balance.String(),
+			Threshold: threshold.String(),
+		})
+	} else if !low && wasLow {
+		logger.Infow("BalanceMonitor: account balance is back above threshold", "address", address.Hex(), "asset", asset, "balance", balance, "chainID", chain.ID)
+	}
+}
+
+func (bm *balanceMonitor) alert(chain store.Chain, alert LowBalanceAlert) {
+	logger.Errorw("BalanceMonitor: account balance is low",
+		"address", alert.Address.Hex(),
+		"chainID", chain.ID,
+		"asset", alert.Asset,
+		"balance", alert.Balance,
+		"threshold", alert.Threshold,
+	)
+
+	alertURL := chain.Config.BalanceMonitorAlertURL()
+	if alertURL == nil {
+		return
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		logger.Error("BalanceMonitor: error encoding alert: ", err)
+		return
+	}
+	client := &http.Client{Timeout: chain.Config.DefaultHTTPTimeout().Duration()}
+	resp, err := client.Post(alertURL.String(), "application/json", bytes.NewReader(body))
+	if err != nil {
+		logger.Error("BalanceMonitor: error sending alert: ", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		logger.Errorw("BalanceMonitor: alert was rejected", "url", alertURL.String(), "status", resp.StatusCode)
+	}
+}
@@ -0,0 +1,122 @@
+package services_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceMonitor_Connect_RecordsBalances(t *testing.T) {
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("BALANCE_MONITOR_ENABLED", "true")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	txm := new(mocks.TxManager)
+	txm.On("GetEthBalance", account.Address).Return(assets.NewEth(42), nil)
+	txm.On("GetLINKBalance", account.Address).Return(assets.NewLink(7), nil)
+	store.TxManager = txm
+
+	bm := services.NewBalanceMonitor(store)
+	defer bm.Stop()
+	assert.Nil(t, bm.EthBalance(account.Address))
+
+	require.NoError(t, bm.Connect(cltest.Head(1)))
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() *assets.Link { return bm.LinkBalance(account.Address) }).Should(gomega.Equal(assets.NewLink(7)))
+	assert.Equal(t, assets.NewEth(42), bm.EthBalance(account.Address))
+	txm.AssertExpectations(t)
+}
+
+func TestBalanceMonitor_Connect_UnknownChain(t *testing.T) {
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("BALANCE_MONITOR_ENABLED", "true")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	bm := services.NewChainBalanceMonitor(store, big.NewInt(424242))
+	defer bm.Stop()
+	assert.Error(t, bm.Connect(cltest.Head(1)))
+}
+
+func TestBalanceMonitor_Connect_WhenDisabledDoesNothing(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	txm := new(mocks.TxManager)
+	store.TxManager = txm
+
+	bm := services.NewBalanceMonitor(store)
+	defer bm.Stop()
+	require.NoError(t, bm.Connect(cltest.Head(1)))
+
+	// No mock calls
+	txm.AssertExpectations(t)
+}
+
+func TestBalanceMonitor_AlertsOnceWhenBalanceIsLow(t *testing.T) {
+	var mutex sync.Mutex
+	var alerts []services.LowBalanceAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert services.LowBalanceAlert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		mutex.Lock()
+		alerts = append(alerts, alert)
+		mutex.Unlock()
+	}))
+	defer server.Close()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("BALANCE_MONITOR_ENABLED", "true")
+	config.Set("BALANCE_MONITOR_ETH_THRESHOLD", "1000")
+	config.Set("BALANCE_MONITOR_ALERT_URL", server.URL)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	account, err := store.KeyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+
+	var checks int32
+	txm := new(mocks.TxManager)
+	txm.On("GetEthBalance", account.Address).Return(assets.NewEth(500), nil)
+	txm.On("GetLINKBalance", account.Address).Return(assets.NewLink(0), nil).
+		Run(func(mock.Arguments) { atomic.AddInt32(&checks, 1) })
+	store.TxManager = txm
+
+	bm := services.NewBalanceMonitor(store)
+	g := gomega.NewGomegaWithT(t)
+	require.NoError(t, bm.Connect(cltest.Head(1)))
+	g.Eventually(func() int32 { return atomic.LoadInt32(&checks) }).Should(gomega.Equal(int32(1)))
+	require.NoError(t, bm.Connect(cltest.Head(2)))
+	g.Eventually(func() int32 { return atomic.LoadInt32(&checks) }).Should(gomega.Equal(int32(2)))
+	require.NoError(t, bm.Stop())
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, account.Address, alerts[0].Address)
+	assert.Equal(t, services.AssetETH, alerts[0].Asset)
+	assert.Equal(t, "500", alerts[0].Balance)
+	assert.Equal(t, "1000", alerts[0].Threshold)
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"math/big"
+
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// resolveChain returns the chain with the given ID, or the default chain if
+// chainID is nil. Services resolve their chain on every use so that the
+// default chain always reflects the store's current TxManager.
+func resolveChain(store *strpkg.Store, chainID *big.Int) (strpkg.Chain, error) {
+	return store.Chain(chainID)
+}
+
+// onChain returns true if the initiator is bound to the given chain.
+// Initiators without a chain ID run on the default chain.
+func onChain(store *strpkg.Store, initr models.Initiator, chain strpkg.Chain) bool {
+	if initr.ChainID == nil {
+		return chain.IsDefault(store.Config)
+	}
+	return initr.ChainID.ToInt().Cmp(chain.ID) == 0
+}
@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"math/big"
 	"os"
 	"os/signal"
 	"sync"
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 
 	"github.com/gobuffalo/packr"
+	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 )
 
@@ -40,9 +42,12 @@ type Application interface {
 	Stop() error
 	GetStore() *strpkg.Store
 	GetStatsPusher() synchronization.StatsPusher
+	GetBalanceMonitor(chainID *big.Int) services.BalanceMonitor
 	WakeSessionReaper()
 	AddJob(job models.JobSpec) error
 	ArchiveJob(*models.ID) error
+	PauseFluxMonitorJob(*models.ID) error
+	ResumeFluxMonitorJob(*models.ID) error
 	AddServiceAgreement(*models.ServiceAgreement) error
 	NewBox() packr.Box
 	services.RunManager
@@ -59,15 +64,48 @@ type ChainlinkApplication struct {
 	RunQueue                 services.RunQueue
 	JobSubscriber            services.JobSubscriber
 	GasUpdater               services.GasUpdater
+	BalanceMonitor           services.BalanceMonitor
 	FluxMonitor              fluxmonitor.Service
 	Scheduler                *services.Scheduler
 	Store                    *strpkg.Store
 	SessionReaper            services.SleeperTask
+	ChainServices            []ChainServices
 	pendingConnectionResumer *pendingConnectionResumer
 	shutdownOnce             sync.Once
 	shutdownSignal           gracefulpanic.Signal
 }
 
+// ChainServices holds the services following one of the additional chains
+// served by the node. The services of the default chain are the
+// HeadTracker, JobSubscriber, GasUpdater and BalanceMonitor of the
+// ChainlinkApplication.
+type ChainServices struct {
+	Chain          strpkg.Chain
+	HeadTracker    *services.HeadTracker
+	JobSubscriber  services.JobSubscriber
+	GasUpdater     services.GasUpdater
+	BalanceMonitor services.BalanceMonitor
+}
+
+func newChainServices(store *strpkg.Store, chain strpkg.Chain, runManager services.RunManager) ChainServices {
+	jobSubscriber := services.NewChainJobSubscriber(store, chain.ID, runManager)
+	gasUpdater := services.NewChainGasUpdater(store, chain.ID)
+	balanceMonitor := services.NewChainBalanceMonitor(store, chain.ID)
+	headTrackables := []strpkg.HeadTrackable{
+		gasUpdater,
+		chain.TxManager,
+		jobSubscriber,
+		balanceMonitor,
+	}
+	return ChainServices{
+		Chain:          chain,
+		HeadTracker:    services.NewChainHeadTracker(store, chain.ID, headTrackables),
+		JobSubscriber:  jobSubscriber,
+		GasUpdater:     gasUpdater,
+		BalanceMonitor: balanceMonitor,
+	}
+}
+
 // NewApplication initializes a new store if one is not already
 // present at the configured root directory (default: ~/.chainlink),
 // the logger at the same directory and returns the Application to
@@ -82,9 +120,11 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 	)
 	runExecutor := services.NewRunExecutor(store, statsPusher)
 	runQueue := services.NewRunQueue(runExecutor)
-	runManager := services.NewRunManager(runQueue, config, store.ORM, statsPusher, store.TxManager, store.Clock)
+	chains := store.Chains()
+	runManager := services.NewChainRunManager(runQueue, config, store.ORM, statsPusher, chains, store.Clock)
 	jobSubscriber := services.NewJobSubscriber(store, runManager)
 	gasUpdater := services.NewGasUpdater(store)
+	balanceMonitor := services.NewBalanceMonitor(store)
 	fluxMonitor := fluxmonitor.New(store, runManager)
 
 	pendingConnectionResumer := newPendingConnectionResumer(runManager)
@@ -92,6 +132,7 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 	app := &ChainlinkApplication{
 		JobSubscriber:            jobSubscriber,
 		GasUpdater:               gasUpdater,
+		BalanceMonitor:           balanceMonitor,
 		FluxMonitor:              fluxMonitor,
 		StatsPusher:              statsPusher,
 		RunManager:               runManager,
@@ -109,6 +150,10 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 		store.TxManager,
 		jobSubscriber,
 		pendingConnectionResumer,
+		balanceMonitor,
+		&txCancellationTracker{store: store},
+		&keyRetirer{store: store},
+		&storeReaperTrigger{reaper: app.SessionReaper},
 	}
 	for _, onConnectCallback := range onConnectCallbacks {
 		headTrackable := &headTrackableCallback{func() {
@@ -118,6 +163,10 @@ func NewApplication(config *orm.Config, onConnectCallbacks ...func(Application))
 	}
 	app.HeadTracker = services.NewHeadTracker(store, headTrackables)
 
+	for _, chain := range chains[1:] {
+		app.ChainServices = append(app.ChainServices, newChainServices(store, chain, runManager))
+	}
+
 	return app
 }
 
@@ -151,11 +200,20 @@ func (app *ChainlinkApplication) Start() error {
 		// which leads to writes of JobRuns RunStatus to the db.
 		// https://www.pivotaltracker.com/story/show/162230780
 		app.HeadTracker.Start(),
+		app.startChainServices(),
 
 		app.Scheduler.Start(),
 	)
 }
 
+func (app *ChainlinkApplication) startChainServices() error {
+	var merr error
+	for _, cs := range app.ChainServices {
+		merr = multierr.Append(merr, cs.HeadTracker.Start())
+	}
+	return merr
+}
+
 // Stop allows the application to exit by halting schedules, closing
 // logs, and closing the DB connection.
 func (app *ChainlinkApplication) Stop() error {
@@ -167,7 +225,13 @@ func (app *ChainlinkApplication) Stop() error {
 		app.Scheduler.Stop()
 		merr = multierr.Append(merr, app.HeadTracker.Stop())
 		app.JobSubscriber.Stop()
+		for _, cs := range app.ChainServices {
+			merr = multierr.Append(merr, cs.HeadTracker.Stop())
+			cs.JobSubscriber.Stop()
+			merr = multierr.Append(merr, cs.BalanceMonitor.Stop())
+		}
 		app.FluxMonitor.Stop()
+		merr = multierr.Append(merr, app.BalanceMonitor.Stop())
 		app.RunQueue.Stop()
 		app.StatsPusher.Close()
 		merr = multierr.Append(merr, app.SessionReaper.Stop())
@@ -185,6 +249,17 @@ func (app *ChainlinkApplication) GetStatsPusher() synchronization.StatsPusher {
 	return app.StatsPusher
 }
 
+// GetBalanceMonitor returns the monitor of the node's account balances on the
+// given chain, or on the default chain if chainID is nil.
+func (app *ChainlinkApplication) GetBalanceMonitor(chainID *big.Int) services.BalanceMonitor {
+	for _, cs := range app.ChainServices {
+		if chainID != nil && cs.Chain.ID.Cmp(chainID) == 0 {
+			return cs.BalanceMonitor
+		}
+	}
+	return app.BalanceMonitor
+}
+
 // WakeSessionReaper wakes up the reaper to do its reaping.
 func (app *ChainlinkApplication) WakeSessionReaper() {
 	app.SessionReaper.WakeUp()
@@ -205,17 +280,66 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 	// an ethereum interaction error.
 	// https://www.pivotaltracker.com/story/show/170349568
 	logger.ErrorIf(app.FluxMonitor.AddJob(job))
-	logger.ErrorIf(app.JobSubscriber.AddJob(job, nil))
+	app.subscribeJob(job)
 	return nil
 }
 
+// subscribeJob subscribes the job's log initiators on every chain served by
+// the node; each JobSubscriber only listens to the initiators bound to its
+// chain.
+func (app *ChainlinkApplication) subscribeJob(job models.JobSpec) {
+	logger.ErrorIf(app.JobSubscriber.AddJob(job, nil))
+	for _, cs := range app.ChainServices {
+		logger.ErrorIf(cs.JobSubscriber.AddJob(job, nil))
+	}
+}
+
 // ArchiveJob silences the job from the system, preventing future job runs.
 func (app *ChainlinkApplication) ArchiveJob(ID *models.ID) error {
 	_ = app.JobSubscriber.RemoveJob(ID)
+	for _, cs := range app.ChainServices {
+		_ = cs.JobSubscriber.RemoveJob(ID)
+	}
 	app.FluxMonitor.RemoveJob(ID)
 	return app.Store.ArchiveJob(ID)
 }
 
+// ErrNotFluxMonitorJob is returned when pausing or resuming a job without
+// flux monitor initiators.
+var ErrNotFluxMonitorJob = errors.New("job has no fluxmonitor initiator")
+
+// PauseFluxMonitorJob stops the flux monitor of the job from polling and
+// submitting answers, keeping its spec, history and log subscription. The job
+// stays paused across restarts, until resumed.
+func (app *ChainlinkApplication) PauseFluxMonitorJob(ID *models.ID) error {
+	return app.setFluxMonitorJobPaused(ID, true)
+}
+
+// ResumeFluxMonitorJob lets the paused flux monitor of the job poll and submit
+// answers again.
+func (app *ChainlinkApplication) ResumeFluxMonitorJob(ID *models.ID) error {
+	return app.setFluxMonitorJobPaused(ID, false)
+}
+
+func (app *ChainlinkApplication) setFluxMonitorJobPaused(ID *models.ID, paused bool) error {
+	job, err := app.Store.FindJob(ID)
+	if err != nil {
+		return err
+	} else if len(job.InitiatorsFor(models.InitiatorFluxMonitor)) == 0 {
+		return ErrNotFluxMonitorJob
+	}
+
+	if err := app.Store.SetFluxMonitorPaused(ID, paused); err != nil {
+		return err
+	}
+	if paused {
+		app.FluxMonitor.PauseJob(ID)
+	} else {
+		app.FluxMonitor.ResumeJob(ID)
+	}
+	return nil
+}
+
 // AddServiceAgreement adds a Service Agreement which includes a job that needs
 // to be scheduled.
 func (app *ChainlinkApplication) AddServiceAgreement(sa *models.ServiceAgreement) error {
@@ -230,7 +354,7 @@ func (app *ChainlinkApplication) AddServiceAgreement(sa *models.ServiceAgreement
 	// an ethereum interaction error.
 	// https://www.pivotaltracker.com/story/show/170349568
 	logger.ErrorIf(app.FluxMonitor.AddJob(sa.JobSpec))
-	logger.ErrorIf(app.JobSubscriber.AddJob(sa.JobSpec, nil))
+	app.subscribeJob(sa.JobSpec)
 	return nil
 }
 
@@ -254,3 +378,43 @@ func (p *pendingConnectionResumer) Connect(head *models.Head) error {
 
 func (p *pendingConnectionResumer) Disconnect()            {}
 func (p *pendingConnectionResumer) OnNewHead(*models.Head) {}
+
+// txCancellationTracker bumps the gas of the transactions of cancelled runs
+// until their cancellation succeeds or fails.
+type txCancellationTracker struct {
+	store *strpkg.Store
+}
+
+func (t *txCancellationTracker) Connect(*models.Head) error { return nil }
+func (t *txCancellationTracker) Disconnect()                {}
+
+func (t *txCancellationTracker) OnNewHead(*models.Head) {
+	if err := t.store.TrackTxCancellations(); err != nil {
+		logger.Errorw("Unable to track transaction cancellations", "error", err)
+	}
+}
+
+// keyRetirer disables the keys being rotated out once their pending
+// transactions are confirmed.
+type keyRetirer struct {
+	store *strpkg.Store
+}
+
+func (k *keyRetirer) Connect(*models.Head) error { return nil }
+func (k *keyRetirer) Disconnect()                {}
+
+func (k *keyRetirer) OnNewHead(*models.Head) {
+	if err := k.store.RetireDrainedKeys(); err != nil {
+		logger.Errorw("Unable to retire drained keys", "error", err)
+	}
+}
+
+// storeReaperTrigger wakes the store reaper on every new head, so that old
+// records are pruned even when nobody signs in.
+type storeReaperTrigger struct {
+	reaper services.SleeperTask
+}
+
+func (s *storeReaperTrigger) Connect(*models.Head) error { return nil }
+func (s *storeReaperTrigger) Disconnect()                {}
+func (s *storeReaperTrigger) OnNewHead(*models.Head)     { s.reaper.WakeUp() }
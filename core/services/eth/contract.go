@@ -49,8 +49,15 @@ func (contract *connectedContract) Call(result interface{}, methodName string, a
 	return errors.Wrap(err, "unable to unpack values")
 }
 
+// SubscribeToLogs registers the listener for the logs of the contract. A
+// listener that decodes only some events of the contract, such as a
+// DecodingLogListener, is registered for the topics of those events only.
 func (contract *connectedContract) SubscribeToLogs(listener LogListener) (connected bool, _ UnsubscribeFunc) {
-	connected = contract.logBroadcaster.Register(contract.address, listener)
+	var topics []common.Hash
+	if filtered, ok := listener.(interface{ Topics() []common.Hash }); ok {
+		topics = filtered.Topics()
+	}
+	connected = contract.logBroadcaster.Register(contract.address, listener, topics...)
 	unsub := func() { contract.logBroadcaster.Unregister(contract.address, listener) }
 	return connected, unsub
 }
@@ -1,20 +1,34 @@
 package contracts
 
 import (
+	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/core/eth"
 	ethsvc "github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 )
 
 //go:generate mockery -name FluxAggregator -output ../../../internal/mocks/ -case=underscore
 
+// FluxAggregator is an aggregator contract the flux monitor can drive. It is
+// implemented for each AggregatorVersion.
 type FluxAggregator interface {
 	ethsvc.ConnectedContract
+	// SubscribeToSubmissions is SubscribeToLogs, also delivering the
+	// SubmissionReceived log of every oracle's answer. Only watchdogs follow
+	// submissions.
+	SubscribeToSubmissions(listener ethsvc.LogListener) (connected bool, _ ethsvc.UnsubscribeFunc)
 	RoundState(oracle common.Address) (FluxAggregatorRoundState, error)
+	LatestAnswer() (*big.Int, error)
+	LatestRoundData() (FluxAggregatorRoundData, error)
+	Oracles() ([]common.Address, error)
 }
 
 const (
@@ -23,6 +37,85 @@ const (
 	FluxAggregatorName = "FluxAggregator"
 )
 
+// AggregatorVersion names an aggregator contract supported by the flux
+// monitor, after the ABI it is driven with.
+type AggregatorVersion string
+
+const (
+	// AggregatorVersionFlux is the v0.6 FluxAggregator.
+	AggregatorVersionFlux AggregatorVersion = FluxAggregatorName
+	// AggregatorVersionWhitelisted is the v0.6 WhitelistedAggregator, a
+	// FluxAggregator whose answers can only be read by whitelisted addresses.
+	AggregatorVersionWhitelisted AggregatorVersion = "WhitelistedAggregator"
+)
+
+// AggregatorVersions are the aggregator contracts supported by the flux
+// monitor.
+var AggregatorVersions = []AggregatorVersion{AggregatorVersionFlux, AggregatorVersionWhitelisted}
+
+// Supported returns whether the flux monitor can drive aggregators of the
+// version.
+func (v AggregatorVersion) Supported() bool {
+	for _, supported := range AggregatorVersions {
+		if v == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// whitelistEnabledSelector is the selector of whitelistEnabled(), which only
+// access controlled aggregators implement.
+var whitelistEnabledSelector = utils.MustHash("whitelistEnabled()").Bytes()[:4]
+
+// DetectAggregatorVersion returns the version of the aggregator contract at
+// the address. Contracts which revert or answer nothing to whitelistEnabled()
+// are FluxAggregators. It errors, rather than guess, when there is no contract
+// at the address or the node cannot reach it.
+func DetectAggregatorVersion(ethClient eth.Client, address common.Address) (AggregatorVersion, error) {
+	var code hexutil.Bytes
+	if err := ethClient.Call(&code, "eth_getCode", address, "latest"); err != nil {
+		return "", errors.Wrap(err, "unable to fetch aggregator code")
+	}
+	if len(code) == 0 {
+		return "", fmt.Errorf("no contract deployed at %s", address.Hex())
+	}
+
+	var result hexutil.Bytes
+	callArgs := eth.CallArgs{To: address, Data: whitelistEnabledSelector}
+	err := ethClient.Call(&result, "eth_call", callArgs, "latest")
+	if err != nil && !isRevert(err) {
+		return "", errors.Wrap(err, "unable to call whitelistEnabled()")
+	}
+	if err == nil && len(result) == 32 {
+		return AggregatorVersionWhitelisted, nil
+	}
+	return AggregatorVersionFlux, nil
+}
+
+// JSON-RPC error codes of eth_calls that reverted
+const (
+	// gethRevertErrorCode is returned by geth for reverts with a reason
+	gethRevertErrorCode = 3
+	// parityVMExecutionErrorCode is returned by Parity and OpenEthereum,
+	// whose message does not mention the revert
+	parityVMExecutionErrorCode = -32015
+)
+
+// isRevert returns whether the eth_call failed because the contract reverted,
+// as opposed to the node failing to run it. Reverts without a reason only
+// carry the generic error code of geth and ganache, and are told apart by
+// their message.
+func isRevert(err error) bool {
+	if rpcErr, ok := errors.Cause(err).(rpc.Error); ok {
+		switch rpcErr.ErrorCode() {
+		case gethRevertErrorCode, parityVMExecutionErrorCode:
+			return true
+		}
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "revert")
+}
+
 var (
 	// AggregatorNewRoundLogTopic20191220 is the NewRound filter topic for
 	// the FluxAggregator as of Dec. 20th 2019. Eagerly fails if not found.
@@ -30,12 +123,19 @@ var (
 	// AggregatorAnswerUpdatedLogTopic20191220 is the AnswerUpdated filter topic for
 	// the FluxAggregator as of Dec. 20th 2019. Eagerly fails if not found.
 	AggregatorAnswerUpdatedLogTopic20191220 = eth.MustGetV6ContractEventID("FluxAggregator", "AnswerUpdated")
+	// AggregatorSubmissionReceivedLogTopic20191220 is the SubmissionReceived
+	// filter topic for the FluxAggregator as of Dec. 20th 2019. Eagerly fails if
+	// not found.
+	AggregatorSubmissionReceivedLogTopic20191220 = eth.MustGetV6ContractEventID("FluxAggregator", "SubmissionReceived")
 )
 
 type fluxAggregator struct {
 	ethsvc.ConnectedContract
 	ethClient eth.Client
 	address   common.Address
+	// reader is the address answers are read from, set for aggregators which
+	// only answer whitelisted addresses.
+	reader *common.Address
 }
 
 type LogNewRound struct {
@@ -53,18 +153,68 @@ type LogAnswerUpdated struct {
 	Timestamp *big.Int
 }
 
+type LogSubmissionReceived struct {
+	eth.Log
+	Submission *big.Int
+	Round      uint32
+	Oracle     common.Address
+}
+
 var fluxAggregatorLogTypes = map[common.Hash]interface{}{
 	AggregatorNewRoundLogTopic20191220:      LogNewRound{},
 	AggregatorAnswerUpdatedLogTopic20191220: LogAnswerUpdated{},
 }
 
+var submissionLogTypes = map[common.Hash]interface{}{
+	AggregatorNewRoundLogTopic20191220:           LogNewRound{},
+	AggregatorAnswerUpdatedLogTopic20191220:      LogAnswerUpdated{},
+	AggregatorSubmissionReceivedLogTopic20191220: LogSubmissionReceived{},
+}
+
+// NewFluxAggregator connects to the FluxAggregator at the address.
 func NewFluxAggregator(address common.Address, ethClient eth.Client, logBroadcaster ethsvc.LogBroadcaster) (FluxAggregator, error) {
-	codec, err := eth.GetV6ContractCodec(FluxAggregatorName)
+	return NewAggregator(AggregatorVersionFlux, address, common.Address{}, ethClient, logBroadcaster)
+}
+
+// NewAggregator connects to the aggregator of the given version at the
+// address. The answers of WhitelistedAggregators are read from the node
+// address, which must be whitelisted.
+func NewAggregator(version AggregatorVersion, address, node common.Address, ethClient eth.Client, logBroadcaster ethsvc.LogBroadcaster) (FluxAggregator, error) {
+	if !version.Supported() {
+		return nil, fmt.Errorf("unsupported aggregator version %s", version)
+	}
+	codec, err := eth.GetV6ContractCodec(string(version))
 	if err != nil {
 		return nil, err
 	}
 	connectedContract := ethsvc.NewConnectedContract(codec, address, ethClient, logBroadcaster)
-	return &fluxAggregator{connectedContract, ethClient, address}, nil
+	fa := &fluxAggregator{ConnectedContract: connectedContract, ethClient: ethClient, address: address}
+	if version == AggregatorVersionWhitelisted {
+		fa.reader = &node
+	}
+	return fa, nil
+}
+
+// readAnswer is Call, made from the reader address if the aggregator only
+// answers whitelisted addresses.
+func (fa *fluxAggregator) readAnswer(result interface{}, methodName string) error {
+	if fa.reader == nil {
+		return fa.Call(result, methodName)
+	}
+	data, err := fa.EncodeMessageCall(methodName)
+	if err != nil {
+		return errors.Wrap(err, "unable to encode message call")
+	}
+
+	var rawResult hexutil.Bytes
+	callArgs := eth.CallArgs{From: fa.reader, To: fa.address, Data: data}
+	if err := fa.ethClient.Call(&rawResult, "eth_call", callArgs, "latest"); err != nil {
+		return errors.Wrapf(err, "unable to call client, is %s whitelisted?", fa.reader.Hex())
+	}
+	if len(rawResult) == 0 {
+		return fmt.Errorf("empty answer to %s, is %s whitelisted?", methodName, fa.reader.Hex())
+	}
+	return errors.Wrap(fa.ABI().Unpack(result, methodName, rawResult), "unable to unpack values")
 }
 
 func (fa *fluxAggregator) SubscribeToLogs(listener ethsvc.LogListener) (connected bool, _ ethsvc.UnsubscribeFunc) {
@@ -73,6 +223,12 @@ func (fa *fluxAggregator) SubscribeToLogs(listener ethsvc.LogListener) (connecte
 	)
 }
 
+func (fa *fluxAggregator) SubscribeToSubmissions(listener ethsvc.LogListener) (connected bool, _ ethsvc.UnsubscribeFunc) {
+	return fa.ConnectedContract.SubscribeToLogs(
+		ethsvc.NewDecodingLogListener(fa, submissionLogTypes, listener),
+	)
+}
+
 type FluxAggregatorRoundState struct {
 	ReportableRoundID uint32   `abi:"_roundId"`
 	EligibleToSubmit  bool     `abi:"_eligibleToSubmit"`
@@ -92,7 +248,44 @@ func (fa *fluxAggregator) RoundState(oracle common.Address) (FluxAggregatorRound
 	var result FluxAggregatorRoundState
 	err := fa.Call(&result, "oracleRoundState", oracle)
 	if err != nil {
-		return FluxAggregatorRoundState{}, errors.Wrap(err, "unable to encode message call")
+		return FluxAggregatorRoundState{}, errors.Wrap(err, "unable to fetch round state")
+	}
+	return result, nil
+}
+
+func (fa *fluxAggregator) LatestAnswer() (*big.Int, error) {
+	var result *big.Int
+	err := fa.readAnswer(&result, "latestAnswer")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch latest answer")
+	}
+	return result, nil
+}
+
+// FluxAggregatorRoundData is the answer of the latest round of an aggregator.
+type FluxAggregatorRoundData struct {
+	RoundID         *big.Int `abi:"roundId"`
+	Answer          *big.Int `abi:"answer"`
+	StartedAt       *big.Int `abi:"startedAt"`
+	UpdatedAt       *big.Int `abi:"updatedAt"`
+	AnsweredInRound *big.Int `abi:"answeredInRound"`
+}
+
+func (fa *fluxAggregator) LatestRoundData() (FluxAggregatorRoundData, error) {
+	var result FluxAggregatorRoundData
+	err := fa.readAnswer(&result, "latestRoundData")
+	if err != nil {
+		return FluxAggregatorRoundData{}, errors.Wrap(err, "unable to fetch latest round data")
+	}
+	return result, nil
+}
+
+// Oracles returns the addresses of the oracles allowed to submit answers.
+func (fa *fluxAggregator) Oracles() ([]common.Address, error) {
+	var result []common.Address
+	err := fa.Call(&result, "getOracles")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch oracles")
 	}
 	return result, nil
 }
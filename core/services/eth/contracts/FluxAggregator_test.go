@@ -2,6 +2,7 @@ package contracts_test
 
 import (
 	"encoding"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -113,3 +114,160 @@ func TestFluxAggregatorClient_DecodesLogs(t *testing.T) {
 	err = fa.UnpackLog(&badAnswerUpdatedLog, "AnswerUpdated", answerUpdatedLogRaw)
 	require.Error(t, err)
 }
+
+func TestAggregatorVersions_DriveTheSameCalls(t *testing.T) {
+	aggregatorAddress := cltest.NewAddress()
+	nodeAddr := cltest.NewAddress()
+	newRoundLogRaw := cltest.LogFromFixture(t, "../../testdata/new_round_log.json")
+
+	for _, version := range contracts.AggregatorVersions {
+		version := version
+		t.Run(string(version), func(t *testing.T) {
+			ethClient := new(mocks.Client)
+			ethClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Return(nil).
+				Run(func(args mock.Arguments) {
+					res := args.Get(0)
+					err := res.(encoding.TextUnmarshaler).UnmarshalText([]byte(cltest.MakeRoundStateReturnData(12, true, 91, 9870, 6, 45, 999, 17)))
+					require.NoError(t, err)
+				})
+
+			fa, err := contracts.NewAggregator(version, aggregatorAddress, nodeAddr, ethClient, nil)
+			require.NoError(t, err)
+
+			roundState, err := fa.RoundState(nodeAddr)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(12), roundState.ReportableRoundID)
+			assert.True(t, roundState.EligibleToSubmit)
+
+			var newRoundLog contracts.LogNewRound
+			require.NoError(t, fa.UnpackLog(&newRoundLog, "NewRound", newRoundLogRaw))
+			assert.Equal(t, int64(1), newRoundLog.RoundId.Int64())
+
+			selector, err := fa.GetMethodID("submit")
+			require.NoError(t, err)
+			assert.Equal(t, utils.MustHash("submit(uint256,int256)").Bytes()[:4], selector)
+		})
+	}
+}
+
+func TestNewAggregator_UnsupportedVersion(t *testing.T) {
+	_, err := contracts.NewAggregator("AccessControlledAggregator", cltest.NewAddress(), cltest.NewAddress(), nil, nil)
+	assert.Error(t, err)
+}
+
+func TestAggregatorVersions_LatestAnswerReader(t *testing.T) {
+	aggregatorAddress := cltest.NewAddress()
+	nodeAddr := cltest.NewAddress()
+	selector := utils.MustHash("latestAnswer()").Bytes()[:4]
+
+	tests := []struct {
+		version contracts.AggregatorVersion
+		from    *common.Address
+	}{
+		{contracts.AggregatorVersionFlux, nil},
+		{contracts.AggregatorVersionWhitelisted, &nodeAddr},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(string(test.version), func(t *testing.T) {
+			expectedCallArgs := eth.CallArgs{From: test.from, To: aggregatorAddress, Data: selector}
+			ethClient := new(mocks.Client)
+			ethClient.On("Call", mock.Anything, "eth_call", expectedCallArgs, "latest").Return(nil).
+				Run(func(args mock.Arguments) {
+					res := args.Get(0)
+					require.NoError(t, res.(encoding.TextUnmarshaler).UnmarshalText([]byte(cltest.MustEVMUintHexFromBase10String(t, "42"))))
+				})
+
+			fa, err := contracts.NewAggregator(test.version, aggregatorAddress, nodeAddr, ethClient, nil)
+			require.NoError(t, err)
+
+			answer, err := fa.LatestAnswer()
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), answer.Int64())
+			ethClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWhitelistedAggregator_NotWhitelisted(t *testing.T) {
+	aggregatorAddress := cltest.NewAddress()
+	nodeAddr := cltest.NewAddress()
+
+	ethClient := new(mocks.Client)
+	ethClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Return(nil)
+
+	fa, err := contracts.NewAggregator(contracts.AggregatorVersionWhitelisted, aggregatorAddress, nodeAddr, ethClient, nil)
+	require.NoError(t, err)
+
+	_, err = fa.LatestRoundData()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), nodeAddr.Hex()+" whitelisted")
+}
+
+type rpcError struct {
+	code    int
+	message string
+}
+
+func (e rpcError) Error() string  { return e.message }
+func (e rpcError) ErrorCode() int { return e.code }
+
+func TestDetectAggregatorVersion(t *testing.T) {
+	aggregatorAddress := cltest.NewAddress()
+	selector := utils.MustHash("whitelistEnabled()").Bytes()[:4]
+	expectedCallArgs := eth.CallArgs{To: aggregatorAddress, Data: selector}
+
+	tests := []struct {
+		name        string
+		code        string
+		codeErr     error
+		response    string
+		callErr     error
+		expected    contracts.AggregatorVersion
+		expectedErr bool
+	}{
+		{"answers whitelistEnabled()", "0x6080", nil, cltest.MustEVMUintHexFromBase10String(t, "1"), nil, contracts.AggregatorVersionWhitelisted, false},
+		{"reverts", "0x6080", nil, "", errors.New("execution reverted"), contracts.AggregatorVersionFlux, false},
+		{"reverts on ganache", "0x6080", nil, "", rpcError{-32000, "VM Exception while processing transaction: revert"}, contracts.AggregatorVersionFlux, false},
+		{"reverts with a reason", "0x6080", nil, "", rpcError{3, "execution reverted: No access"}, contracts.AggregatorVersionFlux, false},
+		{"reverts on parity", "0x6080", nil, "", rpcError{-32015, "VM execution error."}, contracts.AggregatorVersionFlux, false},
+		{"node error", "0x6080", nil, "", rpcError{-32000, "header not found"}, "", true},
+		{"answers nothing", "0x6080", nil, "0x", nil, contracts.AggregatorVersionFlux, false},
+		{"call fails", "0x6080", nil, "", errors.New("connection refused"), "", true},
+		{"no contract", "0x", nil, "", nil, "", true},
+		{"code fetch fails", "", errors.New("connection refused"), "", nil, "", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClient := new(mocks.Client)
+			ethClient.On("Call", mock.Anything, "eth_getCode", aggregatorAddress, "latest").Return(test.codeErr).
+				Run(func(args mock.Arguments) {
+					if test.codeErr == nil {
+						res := args.Get(0)
+						require.NoError(t, res.(encoding.TextUnmarshaler).UnmarshalText([]byte(test.code)))
+					}
+				})
+			if test.code != "0x" && test.codeErr == nil {
+				ethClient.On("Call", mock.Anything, "eth_call", expectedCallArgs, "latest").Return(test.callErr).
+					Run(func(args mock.Arguments) {
+						if test.callErr == nil {
+							res := args.Get(0)
+							require.NoError(t, res.(encoding.TextUnmarshaler).UnmarshalText([]byte(test.response)))
+						}
+					})
+			}
+
+			version, err := contracts.DetectAggregatorVersion(ethClient, aggregatorAddress)
+			if test.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, version)
+			ethClient.AssertExpectations(t)
+		})
+	}
+}
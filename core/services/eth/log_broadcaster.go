@@ -1,9 +1,11 @@
 package eth
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/eth"
@@ -24,11 +26,13 @@ import (
 // The LogBroadcaster manages log subscription requests for the Chainlink node.  Instead
 // of creating a new websocket subscription for each request, it multiplexes all subscriptions
 // to all of the relevant contracts over a single connection and forwards the logs to the
-// relevant subscribers.
+// relevant subscribers. Listeners may restrict the logs they receive to
+// those whose first topic (the event ID) is one of the given topics, which
+// lets the broadcaster filter the logs on the Ethereum node's side.
 type LogBroadcaster interface {
 	utils.DependentAwaiter
 	Start()
-	Register(address common.Address, listener LogListener) (connected bool)
+	Register(address common.Address, listener LogListener, topics ...common.Hash) (connected bool)
 	Unregister(address common.Address, listener LogListener)
 	Stop()
 }
@@ -49,7 +53,7 @@ type logBroadcaster struct {
 	connected     bool
 	started       bool
 
-	listeners        map[common.Address]map[LogListener]struct{}
+	listeners        map[common.Address]map[LogListener]topicSet
 	chAddListener    chan registration
 	chRemoveListener chan registration
 
@@ -58,13 +62,20 @@ type logBroadcaster struct {
 	chDone chan struct{}
 }
 
-// NewLogBroadcaster creates a new instance of the logBroadcaster
+// NewLogBroadcaster creates a new instance of the logBroadcaster for the
+// default chain
 func NewLogBroadcaster(store *store.Store) LogBroadcaster {
+	return NewChainLogBroadcaster(store.ORM, store.DefaultChain())
+}
+
+// NewChainLogBroadcaster creates a new instance of the logBroadcaster
+// multiplexing the log subscriptions of the given chain
+func NewChainLogBroadcaster(orm *orm.ORM, chain store.Chain) LogBroadcaster {
 	return &logBroadcaster{
-		ethClient:        store.TxManager,
-		orm:              store.ORM,
-		backfillDepth:    store.Config.BlockBackfillDepth(),
-		listeners:        make(map[common.Address]map[LogListener]struct{}),
+		ethClient:        chain.TxManager,
+		orm:              orm,
+		backfillDepth:    chain.Config.BlockBackfillDepth(),
+		listeners:        make(map[common.Address]map[LogListener]topicSet),
 		chAddListener:    make(chan registration),
 		chRemoveListener: make(chan registration),
 		chStop:           make(chan struct{}),
@@ -109,6 +120,33 @@ func (lb *logBroadcast) MarkConsumed() error {
 type registration struct {
 	address  common.Address
 	listener LogListener
+	topics   []common.Hash
+}
+
+// topicSet holds the event IDs a listener registered for. A nil set matches
+// every log.
+type topicSet map[common.Hash]struct{}
+
+func newTopicSet(topics []common.Hash) topicSet {
+	if len(topics) == 0 {
+		return nil
+	}
+	set := make(topicSet)
+	for _, topic := range topics {
+		set[topic] = struct{}{}
+	}
+	return set
+}
+
+func (ts topicSet) matches(log eth.Log) bool {
+	if ts == nil {
+		return true
+	}
+	if len(log.Topics) == 0 {
+		return false
+	}
+	_, matches := ts[log.Topics[0]]
+	return matches
 }
 
 // A ManagedSubscription acts as wrapper for the eth.Subscription. Specifically, the
@@ -167,6 +205,41 @@ func (b *logBroadcaster) addresses() []common.Address {
 	return addresses
 }
 
+// topics returns the sorted union of the topics the listeners registered
+// for, or nil if any listener wants every log of its contract.
+func (b *logBroadcaster) topics() []common.Hash {
+	union := make(topicSet)
+	for _, listeners := range b.listeners {
+		for _, topics := range listeners {
+			if topics == nil {
+				return nil
+			}
+			for topic := range topics {
+				union[topic] = struct{}{}
+			}
+		}
+	}
+	var topics []common.Hash
+	for topic := range union {
+		topics = append(topics, topic)
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		return bytes.Compare(topics[i][:], topics[j][:]) < 0
+	})
+	return topics
+}
+
+// filterQuery returns the narrowest single query matching the logs of every
+// listener. Logs matching the query but not a given listener's topics are
+// dropped when routed.
+func (b *logBroadcaster) filterQuery() ethereum.FilterQuery {
+	q := ethereum.FilterQuery{Addresses: b.addresses()}
+	if topics := b.topics(); len(topics) > 0 {
+		q.Topics = [][]common.Hash{topics}
+	}
+	return q
+}
+
 func (b *logBroadcaster) Stop() {
 	close(b.chStop)
 	if b.started {
@@ -176,9 +249,9 @@ func (b *logBroadcaster) Stop() {
 	}
 }
 
-func (b *logBroadcaster) Register(address common.Address, listener LogListener) (connected bool) {
+func (b *logBroadcaster) Register(address common.Address, listener LogListener, topics ...common.Hash) (connected bool) {
 	select {
-	case b.chAddListener <- registration{address, listener}:
+	case b.chAddListener <- registration{address, listener, topics}:
 	case <-b.chStop:
 	}
 	return b.connected
@@ -186,7 +259,7 @@ func (b *logBroadcaster) Register(address common.Address, listener LogListener)
 
 func (b *logBroadcaster) Unregister(address common.Address, listener LogListener) {
 	select {
-	case b.chRemoveListener <- registration{address, listener}:
+	case b.chRemoveListener <- registration{address, listener, nil}:
 	case <-b.chStop:
 	}
 }
@@ -259,10 +332,8 @@ func (b *logBroadcaster) backfillLogs() (chBackfilledLogs chan eth.Log, abort bo
 			fromBlock = 0 // Overflow protection
 		}
 
-		q := ethereum.FilterQuery{
-			FromBlock: big.NewInt(int64(fromBlock)),
-			Addresses: b.addresses(),
-		}
+		q := b.filterQuery()
+		q.FromBlock = big.NewInt(int64(fromBlock))
 
 		logs, err := b.ethClient.GetLogs(q)
 		if err != nil {
@@ -339,11 +410,14 @@ func (b *logBroadcaster) process(subscription eth.Subscription, chRawLogs <-chan
 }
 
 func (b *logBroadcaster) onRawLog(rawLog eth.Log) {
-	for listener := range b.listeners[rawLog.Address] {
+	for listener, topics := range b.listeners[rawLog.Address] {
 		// Ignore duplicate logs sent back due to reorgs
 		if rawLog.Removed {
 			continue
 		}
+		if !topics.matches(rawLog) {
+			continue
+		}
 
 		rawLogCopy := rawLog.Copy()
 		lb := logBroadcast{b.orm, &rawLogCopy, listener.JobID()}
@@ -352,28 +426,31 @@ func (b *logBroadcaster) onRawLog(rawLog eth.Log) {
 }
 
 func (b *logBroadcaster) onAddListener(r registration) (needsResubscribe bool) {
+	topicsBefore := b.topics()
 	_, knownAddress := b.listeners[r.address]
 	if !knownAddress {
-		b.listeners[r.address] = make(map[LogListener]struct{})
+		b.listeners[r.address] = make(map[LogListener]topicSet)
 	}
 	if _, exists := b.listeners[r.address][r.listener]; exists {
 		panic("registration already exists")
 	}
-	b.listeners[r.address][r.listener] = struct{}{}
+	b.listeners[r.address][r.listener] = newTopicSet(r.topics)
 
-	// Recreate the subscription with the new contract address
-	return !knownAddress
+	// Recreate the subscription with the new contract address or topics
+	return !knownAddress || !reflect.DeepEqual(topicsBefore, b.topics())
 }
 
 func (b *logBroadcaster) onRemoveListener(r registration) (needsResubscribe bool) {
 	r.listener.OnDisconnect()
+	topicsBefore := b.topics()
 	delete(b.listeners[r.address], r.listener)
 	if len(b.listeners[r.address]) == 0 {
 		delete(b.listeners, r.address)
 		// Recreate the subscription without this contract address
 		return true
 	}
-	return false
+	// Recreate the subscription if it can be narrowed to fewer topics
+	return !reflect.DeepEqual(topicsBefore, b.topics())
 }
 
 // createSubscription creates a new log subscription starting at the current block.  If previous logs
@@ -385,9 +462,7 @@ func (b *logBroadcaster) createSubscription() (sub ManagedSubscription, abort bo
 	}
 
 	abort = utils.RetryWithBackoff(b.chStop, "creating subscription to Ethereum node", func() error {
-		filterQuery := ethereum.FilterQuery{
-			Addresses: b.addresses(),
-		}
+		filterQuery := b.filterQuery()
 		chRawLogs := make(chan eth.Log)
 
 		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -429,6 +504,16 @@ type decodingLogListener struct {
 
 var _ LogListener = (*decodingLogListener)(nil)
 
+// Topics returns the event IDs of the log types the listener decodes; other
+// logs are ignored.
+func (l *decodingLogListener) Topics() []common.Hash {
+	var topics []common.Hash
+	for eventID := range l.logTypes {
+		topics = append(topics, eventID)
+	}
+	return topics
+}
+
 // NewDecodingLogListener creates a new decodingLogListener
 func NewDecodingLogListener(codec eth.ContractCodec, nativeLogTypes map[common.Hash]interface{}, innerListener LogListener) LogListener {
 	logTypes := make(map[common.Hash]reflect.Type)
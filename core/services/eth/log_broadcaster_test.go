@@ -3,6 +3,7 @@ package eth_test
 import (
 	"errors"
 	"math/big"
+	"reflect"
 	"testing"
 	"time"
 
@@ -288,6 +289,86 @@ func TestLogBroadcaster_BroadcastsToCorrectRecipients(t *testing.T) {
 	sub.AssertExpectations(t)
 }
 
+func TestLogBroadcaster_FiltersAndRoutesByTopic(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	const blockHeight uint64 = 0
+
+	addr := cltest.NewAddress()
+	topicA := common.Hash{1}
+	topicB := common.Hash{2}
+	topicC := common.Hash{3}
+
+	txManager := new(mocks.TxManager)
+	sub := new(mocks.Subscription)
+	store.TxManager = txManager
+
+	expectedQuery := ethereum.FilterQuery{
+		Addresses: []common.Address{addr},
+		Topics:    [][]common.Hash{{topicA, topicB}},
+	}
+	chchRawLogs := make(chan chan<- eth.Log, 1)
+	txManager.On("SubscribeToLogs", mock.Anything, mock.Anything, expectedQuery).
+		Run(func(args mock.Arguments) {
+			chchRawLogs <- args.Get(1).(chan<- eth.Log)
+		}).
+		Return(sub, nil).
+		Once()
+	txManager.On("GetLatestBlock").
+		Return(eth.Block{Number: hexutil.Uint64(blockHeight)}, nil)
+	txManager.On("GetLogs", mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+		return reflect.DeepEqual(q.Topics, expectedQuery.Topics)
+	})).
+		Return(nil, nil)
+	sub.On("Err").Return(nil)
+	sub.On("Unsubscribe").Return()
+
+	lb := ethsvc.NewLogBroadcaster(store)
+	lb.Start()
+
+	var logsA, logsB []interface{}
+	listenerA := simpleLogListener{
+		func(lb ethsvc.LogBroadcast, err error) {
+			require.NoError(t, err)
+			logsA = append(logsA, lb.Log())
+			handleLogBroadcast(t, lb)
+		},
+		createJob(t, store).ID,
+	}
+	listenerB := simpleLogListener{
+		func(lb ethsvc.LogBroadcast, err error) {
+			require.NoError(t, err)
+			logsB = append(logsB, lb.Log())
+			handleLogBroadcast(t, lb)
+		},
+		createJob(t, store).ID,
+	}
+	lb.Register(addr, &listenerA, topicA)
+	lb.Register(addr, &listenerB, topicB)
+
+	chRawLogs := <-chchRawLogs
+	sentLogs := []eth.Log{
+		{Address: addr, BlockNumber: 1, BlockHash: cltest.NewHash(), Topics: []common.Hash{topicA}},
+		{Address: addr, BlockNumber: 2, BlockHash: cltest.NewHash(), Topics: []common.Hash{topicB}},
+		{Address: addr, BlockNumber: 3, BlockHash: cltest.NewHash(), Topics: []common.Hash{topicC}},
+		{Address: addr, BlockNumber: 4, BlockHash: cltest.NewHash()},
+	}
+	for _, log := range sentLogs {
+		chRawLogs <- log
+	}
+
+	requireLogConsumptionCount(t, store, 2)
+	lb.Stop()
+
+	require.Equal(t, []interface{}{&sentLogs[0]}, logsA)
+	require.Equal(t, []interface{}{&sentLogs[1]}, logsB)
+
+	txManager.AssertExpectations(t)
+}
+
 func TestLogBroadcaster_Register_ResubscribesToMostRecentlySeenBlock(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,163 @@
+package fluxmonitor
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// PricePoint is the true answer of a feed from the given time on.
+type PricePoint struct {
+	Time   time.Time
+	Answer decimal.Decimal
+}
+
+// BacktestParams are the deviation thresholds and timers of a flux monitor
+// initiator to evaluate.
+type BacktestParams struct {
+	Threshold         float64 `json:"threshold"`
+	AbsoluteThreshold float64 `json:"absoluteThreshold"`
+	// PollPeriod is the period of the poll timer, zero disabling it.
+	PollPeriod models.Duration `json:"pollPeriod"`
+	// IdleDuration is the duration of the idle timer, zero disabling it.
+	IdleDuration models.Duration `json:"idleDuration"`
+}
+
+// BacktestResult is what a set of parameters would have produced over a
+// price series.
+type BacktestResult struct {
+	BacktestParams
+	Submissions int `json:"submissions"`
+	// MaxDeviation is the largest deviation of the last submitted answer from
+	// the true answer over the series, as a percentage of the submitted
+	// answer.
+	MaxDeviation decimal.Decimal `json:"maxDeviation"`
+	GasCost      *assets.Eth     `json:"gasCost"`
+}
+
+// Backtest replays the price series through the deviation checks and idle
+// timer of PollingDeviationChecker, starting from an aggregator without any
+// answer, and reports the submissions it would have made. Each submission is
+// assumed to use gasLimit at gasPrice, and the deviation checks are logged to
+// log. Round timeouts and the answers of other oracles are not simulated.
+func Backtest(series []PricePoint, params BacktestParams, gasLimit uint64, gasPrice *big.Int, log *logger.Logger) (BacktestResult, error) {
+	result := BacktestResult{BacktestParams: params, GasCost: assets.NewEth(0)}
+	if len(series) == 0 {
+		return result, errors.New("cannot backtest an empty price series")
+	}
+	pollPeriod := params.PollPeriod.Duration()
+	idleDuration := params.IdleDuration.Duration()
+	if pollPeriod <= 0 && idleDuration <= 0 {
+		return result, errors.New("must enable the poll timer, the idle timer, or both")
+	}
+
+	points := append([]PricePoint{}, series...)
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+	start := points[0].Time
+	end := points[len(points)-1].Time
+	thresholds := DeviationThresholds{Rel: params.Threshold, Abs: params.AbsoluteThreshold}
+
+	var submitted *decimal.Decimal
+	next := 0 // index of the first point not yet observed
+	current := points[0].Answer
+	observeUntil := func(t time.Time) {
+		for ; next < len(points) && !points[next].Time.After(t); next++ {
+			current = points[next].Answer
+			if submitted == nil {
+				continue
+			}
+			deviation := relativeDeviation(*submitted, current)
+			if deviation.Valid && deviation.Decimal.GreaterThan(result.MaxDeviation) {
+				result.MaxDeviation = deviation.Decimal
+			}
+		}
+	}
+
+	nextPoll := start
+	idleDeadline := start.Add(idleDuration)
+	for {
+		pollFires := pollPeriod > 0
+		if pollFires && idleDuration > 0 {
+			pollFires = !nextPoll.After(idleDeadline)
+		}
+
+		var now time.Time
+		var eventThresholds DeviationThresholds
+		if pollFires {
+			now, eventThresholds = nextPoll, thresholds
+			nextPoll = nextPoll.Add(pollPeriod)
+		} else {
+			// The idle timer submits whatever the deviation, like pollIfEligible
+			// called with zero thresholds.
+			now = idleDeadline
+			idleDeadline = idleDeadline.Add(idleDuration)
+		}
+		if now.After(end) {
+			break
+		}
+
+		observeUntil(now)
+		if submitted == nil || outsideDeviation(log, *submitted, current, eventThresholds) {
+			answer := current
+			submitted = &answer
+			result.Submissions++
+			// The idle timer restarts with each new round
+			idleDeadline = now.Add(idleDuration)
+		}
+	}
+	observeUntil(end)
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice)
+	cost.Mul(cost, big.NewInt(int64(result.Submissions)))
+	result.GasCost = (*assets.Eth)(cost)
+	return result, nil
+}
+
+// ParsePriceSeries reads a price series from CSV rows of a time, as unix
+// seconds or RFC3339, and an answer. A header row is skipped.
+func ParsePriceSeries(r io.Reader) ([]PricePoint, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read price series")
+	}
+
+	var series []PricePoint
+	for i, row := range rows {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("line %d: expected a time and an answer, got %d fields", i+1, len(row))
+		}
+		timestamp, err := parseSeriesTime(strings.TrimSpace(row[0]))
+		if err != nil && i == 0 {
+			continue
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "line %d", i+1)
+		}
+		answer, err := decimal.NewFromString(strings.TrimSpace(row[1]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", i+1)
+		}
+		series = append(series, PricePoint{Time: timestamp, Answer: answer})
+	}
+	return series, nil
+}
+
+func parseSeriesTime(s string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,84 @@
+package fluxmonitor_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBacktest(t *testing.T) {
+	start := time.Unix(1591000000, 0)
+	series := []fluxmonitor.PricePoint{
+		{Time: start, Answer: decimal.NewFromInt(100)},
+		{Time: start.Add(90 * time.Second), Answer: decimal.NewFromInt(101)},
+		{Time: start.Add(150 * time.Second), Answer: decimal.NewFromInt(110)},
+		{Time: start.Add(10 * time.Minute), Answer: decimal.NewFromInt(110)},
+	}
+
+	tests := []struct {
+		name                string
+		params              fluxmonitor.BacktestParams
+		expectedSubmissions int
+		expectedDeviation   string
+	}{
+		{"tight threshold",
+			fluxmonitor.BacktestParams{Threshold: 0.5, PollPeriod: models.MustMakeDuration(time.Minute)},
+			3, "8.91"},
+		{"loose threshold",
+			fluxmonitor.BacktestParams{Threshold: 5, PollPeriod: models.MustMakeDuration(time.Minute)},
+			2, "10.00"},
+		{"absolute threshold",
+			fluxmonitor.BacktestParams{AbsoluteThreshold: 20, PollPeriod: models.MustMakeDuration(time.Minute)},
+			1, "10.00"},
+		{"idle timer",
+			fluxmonitor.BacktestParams{AbsoluteThreshold: 20, PollPeriod: models.MustMakeDuration(time.Minute), IdleDuration: models.MustMakeDuration(4 * time.Minute)},
+			3, "10.00"},
+		{"idle timer only",
+			fluxmonitor.BacktestParams{IdleDuration: models.MustMakeDuration(2 * time.Minute)},
+			5, "8.91"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := fluxmonitor.Backtest(series, test.params, 100000, big.NewInt(10), logger.GetLogger())
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSubmissions, result.Submissions)
+			assert.Equal(t, test.expectedDeviation, result.MaxDeviation.StringFixed(2))
+			assert.Equal(t, big.NewInt(int64(test.expectedSubmissions)*1000000), result.GasCost.ToInt())
+		})
+	}
+}
+
+func TestBacktest_Errors(t *testing.T) {
+	_, err := fluxmonitor.Backtest(nil, fluxmonitor.BacktestParams{PollPeriod: models.MustMakeDuration(time.Minute)}, 1, big.NewInt(1), logger.GetLogger())
+	assert.Error(t, err)
+
+	series := []fluxmonitor.PricePoint{{Time: time.Now(), Answer: decimal.NewFromInt(1)}}
+	_, err = fluxmonitor.Backtest(series, fluxmonitor.BacktestParams{Threshold: 1}, 1, big.NewInt(1), logger.GetLogger())
+	assert.Error(t, err)
+}
+
+func TestParsePriceSeries(t *testing.T) {
+	series, err := fluxmonitor.ParsePriceSeries(strings.NewReader("time,answer\n1591000000,100.5\n2020-06-01T08:30:00Z, 101\n"))
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+	assert.Equal(t, time.Unix(1591000000, 0), series[0].Time)
+	assert.Equal(t, "100.5", series[0].Answer.String())
+	assert.Equal(t, time.Date(2020, 6, 1, 8, 30, 0, 0, time.UTC), series[1].Time.UTC())
+	assert.Equal(t, "101", series[1].Answer.String())
+
+	_, err = fluxmonitor.ParsePriceSeries(strings.NewReader("1591000000,100\nyesterday,101\n"))
+	assert.Error(t, err)
+	_, err = fluxmonitor.ParsePriceSeries(strings.NewReader("1591000000,abc\n"))
+	assert.Error(t, err)
+}
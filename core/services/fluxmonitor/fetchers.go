@@ -7,8 +7,12 @@ import (
 	"net/url"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/guregu/null"
@@ -26,11 +30,17 @@ type Fetcher interface {
 	Fetch() (decimal.Decimal, error)
 }
 
+// errStaleAnswer is returned by fetchers whose answer is older than the
+// maximum age allowed for their feed.
+var errStaleAnswer = errors.New("stale answer")
+
 // httpFetcher retrieves data via HTTP from an external price adapter source.
 type httpFetcher struct {
 	client      *http.Client
 	url         *url.URL
 	requestData string
+	// maxAge rejects answers whose timestamp is older, when set.
+	maxAge time.Duration
 }
 
 func newHTTPFetcher(
@@ -71,6 +81,9 @@ func (p *httpFetcher) Fetch() (decimal.Decimal, error) {
 	if result == nil {
 		return decimal.Decimal{}, errors.Wrap(errors.New("no result returned"), fmt.Sprintf("unable to fetch price from %s", p.url.String()))
 	}
+	if err = p.checkAge(target.Data.Timestamp); err != nil {
+		return decimal.Decimal{}, err
+	}
 
 	resultFloat, _ := result.Float64()
 	promFMIndividualReportedValue.WithLabelValues(p.url.String()).Set(resultFloat)
@@ -82,12 +95,88 @@ func (p *httpFetcher) Fetch() (decimal.Decimal, error) {
 	return *result, nil
 }
 
+// checkAge errors with errStaleAnswer if the feed has a maximum age, and the
+// answer has no timestamp or one older than it.
+func (p *httpFetcher) checkAge(timestamp *int64) error {
+	if p.maxAge == 0 {
+		return nil
+	} else if timestamp == nil {
+		return errors.Wrapf(errStaleAnswer, "no timestamp returned by %s, which has a maximum age of %s", p.url.String(), p.maxAge)
+	}
+	age := time.Since(time.Unix(*timestamp, 0))
+	if age > p.maxAge {
+		return errors.Wrapf(errStaleAnswer, "answer from %s is %s old, more than its maximum age of %s", p.url.String(), age.Round(time.Second), p.maxAge)
+	}
+	return nil
+}
+
 func (p *httpFetcher) String() string {
 	return fmt.Sprintf("http price fetcher: %s", p.url.String())
 }
 
+// pipelineFetcher computes the price by performing the off-chain tasks of the
+// job one after the other, the way a run would, starting from the request
+// data of the initiator, which also provides the params tasks do not set.
+type pipelineFetcher struct {
+	store       *store.Store
+	tasks       []models.TaskSpec
+	requestData models.JSON
+}
+
+func newPipelineFetcher(store *store.Store, tasks []models.TaskSpec, requestData models.JSON) (Fetcher, error) {
+	if len(tasks) == 0 {
+		return nil, errors.New("job has no off-chain tasks to compute the answer")
+	}
+	return &pipelineFetcher{store: store, tasks: tasks, requestData: requestData}, nil
+}
+
+func (p *pipelineFetcher) Fetch() (decimal.Decimal, error) {
+	runID := models.NewID()
+	data := p.requestData
+	for _, task := range p.tasks {
+		params, err := models.Merge(p.requestData, task.Params)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		task.Params = params
+
+		adapter, err := adapters.For(task, p.store.Config, p.store.ORM)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		input := models.NewRunInput(runID, data, models.RunStatusInProgress)
+		output := adapter.Perform(*input, p.store)
+		if output.HasError() {
+			return decimal.Decimal{}, errors.Wrapf(output.Error(), "task %s failed", task.Type)
+		} else if !output.Status().Completed() {
+			return decimal.Decimal{}, fmt.Errorf("task %s did not complete immediately, and cannot compute answers", task.Type)
+		}
+		if data, err = models.Merge(data, output.Data()); err != nil {
+			return decimal.Decimal{}, err
+		}
+	}
+
+	result, err := decimal.NewFromString(data.Get("result").String())
+	if err != nil {
+		return decimal.Decimal{}, errors.Wrap(err, "unable to parse the result of the job's tasks")
+	}
+	logger.Debugw(fmt.Sprintf("computed price %v with the job's tasks", result), "price", result)
+	return result, nil
+}
+
+func (p *pipelineFetcher) String() string {
+	types := make([]string, len(p.tasks))
+	for i, task := range p.tasks {
+		types[i] = task.Type.String()
+	}
+	return fmt.Sprintf("pipeline price fetcher: %s", strings.Join(types, ","))
+}
+
 type adapterResponseData struct {
 	Result *decimal.Decimal `json:"result"`
+	// Timestamp is the unix time in seconds at which the result was observed,
+	// for feeds with a maximum age.
+	Timestamp *int64 `json:"timestamp,omitempty"`
 }
 
 // adapterResponse is the HTTP response as defined by the external adapter:
@@ -101,10 +190,50 @@ func (pr adapterResponse) Result() *decimal.Decimal {
 	return pr.Data.Result
 }
 
-// medianFetcher fetches from all fetchers, and returns the median value, or
-// average if even number of results.
+// Reasons for which a source is left out of the median.
+const (
+	DropReasonError   = "error"
+	DropReasonStale   = "stale"
+	DropReasonOutlier = "outlier"
+)
+
+// DroppedSource is a source left out of a polled answer, and why.
+type DroppedSource struct {
+	Source string `json:"source"`
+	Reason string `json:"reason"`
+}
+
+// droppedSourcesReporter is implemented by fetchers answering from several
+// sources, to report which were left out of their last answer.
+type droppedSourcesReporter interface {
+	DroppedSources() []DroppedSource
+}
+
+// droppedSources returns the sources left out of the last answer of the
+// fetcher, if it reports them.
+func droppedSources(fetcher Fetcher) []DroppedSource {
+	if reporter, ok := fetcher.(droppedSourcesReporter); ok {
+		return reporter.DroppedSources()
+	}
+	return nil
+}
+
+// medianFetcher fetches from all fetchers, drops those which failed, answered
+// too late, or too far from the others, and returns the median value of the
+// rest, or average if even number of results.
 type medianFetcher struct {
 	fetchers []Fetcher
+	// minResponses is the number of answers required to survive, a majority
+	// of the fetchers when zero.
+	minResponses int
+	// outlierThreshold is the number of median absolute deviations from the
+	// median beyond which answers are dropped, zero disabling the filtering.
+	// Answers are kept when most of them agree exactly, and the deviation is
+	// zero.
+	outlierThreshold float64
+
+	droppedMutex sync.Mutex
+	dropped      []DroppedSource
 }
 
 // newMedianFetcherFromURLs creates a median fetcher that retrieves a price
@@ -114,17 +243,41 @@ func newMedianFetcherFromURLs(
 	requestData string,
 	priceURLs []*url.URL,
 ) (Fetcher, error) {
+	feeds := make([]Feed, len(priceURLs))
+	for i, url := range priceURLs {
+		feeds[i] = Feed{URL: url}
+	}
+	return newMedianFetcherFromFeeds(timeout, requestData, feeds, 0, 0)
+}
+
+// newMedianFetcherFromFeeds creates a median fetcher that retrieves a price
+// from all passed feeds using httpFetcher, and returns the median of those
+// recent and close enough to the others, if there are at least minResponses
+// of them.
+func newMedianFetcherFromFeeds(
+	timeout models.Duration,
+	requestData string,
+	feeds []Feed,
+	minResponses int32,
+	outlierThreshold float32,
+) (Fetcher, error) {
+	if int(minResponses) > len(feeds) {
+		return nil, fmt.Errorf("minResponses %d exceeds the %d feeds", minResponses, len(feeds))
+	}
 	fetchers := []Fetcher{}
-	for _, url := range priceURLs {
-		ps := newHTTPFetcher(timeout, requestData, url)
+	for _, feed := range feeds {
+		ps := newHTTPFetcher(timeout, requestData, feed.URL).(*httpFetcher)
+		ps.maxAge = feed.MaxAge
 		fetchers = append(fetchers, ps)
 	}
 
-	medianFetcher, err := newMedianFetcher(fetchers...)
+	fetcher, err := newMedianFetcher(fetchers...)
 	if err != nil {
 		return nil, err
 	}
-
+	medianFetcher := fetcher.(*medianFetcher)
+	medianFetcher.minResponses = int(minResponses)
+	medianFetcher.outlierThreshold = float64(outlierThreshold)
 	return medianFetcher, nil
 }
 
@@ -138,12 +291,10 @@ func newMedianFetcher(fetchers ...Fetcher) (Fetcher, error) {
 }
 
 func (m *medianFetcher) Fetch() (decimal.Decimal, error) {
-	prices := []decimal.Decimal{}
-	fetchErrors := []error{}
-
 	type result struct {
-		price decimal.Decimal
-		err   error
+		fetcher Fetcher
+		price   decimal.Decimal
+		err     error
 	}
 
 	chResults := make(chan result)
@@ -153,35 +304,88 @@ func (m *medianFetcher) Fetch() (decimal.Decimal, error) {
 			price, err := fetcher.Fetch()
 			if err != nil {
 				logger.Error(err)
-				chResults <- result{err: err}
+				chResults <- result{fetcher: fetcher, err: err}
 			} else {
-				chResults <- result{price: price}
+				chResults <- result{fetcher: fetcher, price: price}
 			}
 		}()
 	}
 
+	answers := []result{}
+	fetchErrors := []error{}
+	dropped := []DroppedSource{}
 	for i := 0; i < len(m.fetchers); i++ {
 		r := <-chResults
-		if r.err != nil {
-			fetchErrors = append(fetchErrors, r.err)
-		} else {
-			prices = append(prices, r.price)
+		if r.err == nil {
+			answers = append(answers, r)
+			continue
+		}
+		fetchErrors = append(fetchErrors, r.err)
+		reason := DropReasonError
+		if errors.Cause(r.err) == errStaleAnswer {
+			reason = DropReasonStale
 		}
+		dropped = append(dropped, DroppedSource{Source: sourceName(r.fetcher), Reason: reason})
 	}
 
-	errorRate := float64(len(fetchErrors)) / float64(len(m.fetchers))
-	if errorRate >= 0.5 {
-		return decimal.Decimal{}, errors.Wrap(multierr.Combine(fetchErrors...), "majority of fetchers in median failed")
+	if m.outlierThreshold > 0 && len(answers) >= 3 {
+		prices := make([]decimal.Decimal, len(answers))
+		for i, r := range answers {
+			prices[i] = r.price
+		}
+		center := median(prices)
+		maxDeviation := medianAbsoluteDeviation(prices, center).Mul(decimal.NewFromFloat(m.outlierThreshold))
+		if maxDeviation.IsPositive() {
+			kept := []result{}
+			for _, r := range answers {
+				if r.price.Sub(center).Abs().GreaterThan(maxDeviation) {
+					dropped = append(dropped, DroppedSource{Source: sourceName(r.fetcher), Reason: DropReasonOutlier})
+				} else {
+					kept = append(kept, r)
+				}
+			}
+			answers = kept
+		}
 	}
+	m.recordDropped(dropped)
 
-	sort.Slice(prices, func(i, j int) bool {
-		return prices[i].LessThan(prices[j])
-	})
-	k := len(prices) / 2
-	if len(prices)%2 == 1 {
-		return prices[k], nil
+	minResponses := m.minResponses
+	if minResponses == 0 {
+		minResponses = len(m.fetchers)/2 + 1
+	}
+	if len(answers) < minResponses {
+		err := fmt.Errorf("only %d of %d fetchers in median answered, %d required", len(answers), len(m.fetchers), minResponses)
+		if len(fetchErrors) > 0 {
+			err = errors.Wrap(multierr.Combine(fetchErrors...), err.Error())
+		}
+		return decimal.Decimal{}, err
 	}
-	return prices[k].Add(prices[k-1]).Div(decimal.NewFromInt(2)), nil
+
+	prices := make([]decimal.Decimal, len(answers))
+	for i, r := range answers {
+		prices[i] = r.price
+	}
+	return median(prices), nil
+}
+
+// recordDropped keeps the sources dropped by the last poll, and counts them
+// in the metrics.
+func (m *medianFetcher) recordDropped(dropped []DroppedSource) {
+	for _, d := range dropped {
+		promFMDroppedSources.WithLabelValues(d.Source, d.Reason).Inc()
+		logger.Warnw(fmt.Sprintf("dropped %s from median: %s", d.Source, d.Reason), "source", d.Source, "reason", d.Reason)
+	}
+
+	m.droppedMutex.Lock()
+	defer m.droppedMutex.Unlock()
+	m.dropped = dropped
+}
+
+// DroppedSources returns the sources left out of the last answer.
+func (m *medianFetcher) DroppedSources() []DroppedSource {
+	m.droppedMutex.Lock()
+	defer m.droppedMutex.Unlock()
+	return append([]DroppedSource{}, m.dropped...)
 }
 
 func (m *medianFetcher) String() string {
@@ -191,3 +395,35 @@ func (m *medianFetcher) String() string {
 	}
 	return fmt.Sprintf("median fetcher: %s", strings.Join(fetcherDescriptions, ","))
 }
+
+// sourceName identifies the source of a fetcher, by URL for HTTP fetchers.
+func sourceName(fetcher Fetcher) string {
+	if hf, ok := fetcher.(*httpFetcher); ok {
+		return hf.url.String()
+	}
+	return fmt.Sprintf("%v", fetcher)
+}
+
+// median returns the median of the prices, or the average of the two middle
+// ones if there is an even number of them.
+func median(prices []decimal.Decimal) decimal.Decimal {
+	sorted := append([]decimal.Decimal{}, prices...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
+	}
+	return sorted[k].Add(sorted[k-1]).Div(decimal.NewFromInt(2))
+}
+
+// medianAbsoluteDeviation returns the median of the distances of the prices
+// to center.
+func medianAbsoluteDeviation(prices []decimal.Decimal, center decimal.Decimal) decimal.Decimal {
+	deviations := make([]decimal.Decimal, len(prices))
+	for i, price := range prices {
+		deviations[i] = price.Sub(center).Abs()
+	}
+	return median(deviations)
+}
@@ -2,6 +2,7 @@ package fluxmonitor
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/guregu/null"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -279,3 +281,113 @@ func TestMedianFetcher_MinorityErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPFetcher_MaxAge(t *testing.T) {
+	tests := []struct {
+		name      string
+		timestamp string
+		wantStale bool
+	}{
+		{"recent", fmt.Sprintf(`,"timestamp":%d`, time.Now().Unix()), false},
+		{"stale", fmt.Sprintf(`,"timestamp":%d`, time.Now().Add(-time.Hour).Unix()), true},
+		{"missing", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte(fmt.Sprintf(`{"data":{"result":100%s}}`, test.timestamp)))
+				require.NoError(t, err)
+			})
+			server := httptest.NewServer(handler)
+			defer server.Close()
+			feedURL, err := url.ParseRequestURI(server.URL)
+			require.NoError(t, err)
+
+			fetcher := newHTTPFetcher(defaultHTTPTimeout, ethUSDPairing, feedURL).(*httpFetcher)
+			fetcher.maxAge = time.Minute
+			price, err := fetcher.Fetch()
+			if test.wantStale {
+				require.Error(t, err)
+				assert.Equal(t, errStaleAnswer, errors.Cause(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, decimal.NewFromInt(100).String(), price.String())
+			}
+		})
+	}
+}
+
+func TestMedianFetcher_MinResponses(t *testing.T) {
+	hf := newFixedPricedFetcher(decimal.NewFromInt(100))
+	ef := newErroringPricedFetcher()
+
+	fetcher, err := newMedianFetcher(hf, ef, ef)
+	require.NoError(t, err)
+	mf := fetcher.(*medianFetcher)
+
+	_, err = mf.Fetch()
+	assert.Error(t, err)
+
+	mf.minResponses = 1
+	price, err := mf.Fetch()
+	require.NoError(t, err)
+	assert.Equal(t, "100", price.String())
+
+	fetcher, err = newMedianFetcher(hf, hf, hf, hf)
+	require.NoError(t, err)
+	mf = fetcher.(*medianFetcher)
+	mf.minResponses = 4
+	_, err = mf.Fetch()
+	assert.NoError(t, err)
+}
+
+func TestMedianFetcher_DropsOutliers(t *testing.T) {
+	hf99 := newFixedPricedFetcher(decimal.NewFromInt(99))
+	hf100 := newFixedPricedFetcher(decimal.NewFromInt(100))
+	hf101 := newFixedPricedFetcher(decimal.NewFromInt(101))
+	hf102 := newFixedPricedFetcher(decimal.NewFromInt(102))
+	hf999 := newFixedPricedFetcher(decimal.NewFromInt(999))
+	ef := newErroringPricedFetcher()
+
+	tests := []struct {
+		name           string
+		fetchers       []Fetcher
+		threshold      float64
+		minResponses   int
+		expectedMedian string
+		expectedDrops  map[string]int
+		wantError      bool
+	}{
+		{"disabled", []Fetcher{hf99, hf100, hf999, hf101}, 0, 0, "100.5", map[string]int{}, false},
+		{"outlier", []Fetcher{hf99, hf100, hf999, hf101}, 3, 0, "100", map[string]int{DropReasonOutlier: 1}, false},
+		{"outlier and error", []Fetcher{hf99, hf100, hf101, hf102, hf999, ef}, 3, 0, "100.5", map[string]int{DropReasonOutlier: 1, DropReasonError: 1}, false},
+		{"too few remaining", []Fetcher{hf99, hf100, hf999, hf101}, 3, 4, "0", map[string]int{DropReasonOutlier: 1}, true},
+		{"zero deviation", []Fetcher{hf100, hf100, hf100, hf999}, 3, 0, "100", map[string]int{}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fetcher, err := newMedianFetcher(test.fetchers...)
+			require.NoError(t, err)
+			mf := fetcher.(*medianFetcher)
+			mf.outlierThreshold = test.threshold
+			mf.minResponses = test.minResponses
+
+			medianPrice, err := mf.Fetch()
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedMedian, medianPrice.String())
+
+			drops := map[string]int{}
+			for _, dropped := range mf.DroppedSources() {
+				drops[dropped.Reason]++
+			}
+			assert.Equal(t, test.expectedDrops, drops)
+		})
+	}
+}
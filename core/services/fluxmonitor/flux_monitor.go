@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -21,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
 	"github.com/shopspring/decimal"
 	"github.com/tevino/abool"
 )
@@ -43,30 +45,42 @@ type RunManager interface {
 type Service interface {
 	AddJob(models.JobSpec) error
 	RemoveJob(*models.ID)
+	PauseJob(*models.ID)
+	ResumeJob(*models.ID)
 	Start() error
 	Stop()
 }
 
 type concreteFluxMonitor struct {
-	store          *store.Store
-	runManager     RunManager
-	logBroadcaster eth.LogBroadcaster
-	checkerFactory DeviationCheckerFactory
-	chAdd          chan addEntry
-	chRemove       chan models.ID
-	chConnect      chan *models.Head
-	chDisconnect   chan struct{}
-	chStop         chan struct{}
-	chDone         chan struct{}
-	disabled       bool
-	started        bool
+	store           *store.Store
+	runManager      RunManager
+	logBroadcasters logBroadcasters
+	checkerFactory  DeviationCheckerFactory
+	chAdd           chan addEntry
+	chRemove        chan models.ID
+	chPause         chan pauseEntry
+	chConnect       chan *models.Head
+	chDisconnect    chan struct{}
+	chStop          chan struct{}
+	chDone          chan struct{}
+	disabled        bool
+	started         bool
 }
 
+// logBroadcasters holds a log broadcaster for each chain served by the node,
+// keyed by chain ID.
+type logBroadcasters map[string]eth.LogBroadcaster
+
 type addEntry struct {
 	jobID    models.ID
 	checkers []DeviationChecker
 }
 
+type pauseEntry struct {
+	jobID  models.ID
+	paused bool
+}
+
 // New creates a service that manages a collection of DeviationCheckers,
 // one per initiator of type InitiatorFluxMonitor for added jobs.
 func New(
@@ -77,17 +91,21 @@ func New(
 		return &concreteFluxMonitor{disabled: true}
 	}
 
-	logBroadcaster := eth.NewLogBroadcaster(store)
+	broadcasters := logBroadcasters{}
+	for _, chain := range store.Chains() {
+		broadcasters[chain.ID.String()] = eth.NewChainLogBroadcaster(store.ORM, chain)
+	}
 	return &concreteFluxMonitor{
-		store:          store,
-		runManager:     runManager,
-		logBroadcaster: logBroadcaster,
+		store:           store,
+		runManager:      runManager,
+		logBroadcasters: broadcasters,
 		checkerFactory: pollingDeviationCheckerFactory{
-			store:          store,
-			logBroadcaster: logBroadcaster,
+			store:           store,
+			logBroadcasters: broadcasters,
 		},
 		chAdd:        make(chan addEntry),
 		chRemove:     make(chan models.ID),
+		chPause:      make(chan pauseEntry),
 		chConnect:    make(chan *models.Head),
 		chDisconnect: make(chan struct{}),
 		chStop:       make(chan struct{}),
@@ -126,7 +144,9 @@ func (fm *concreteFluxMonitor) Start() error {
 	}, models.InitiatorFluxMonitor)
 
 	wg.Wait()
-	fm.logBroadcaster.Start()
+	for _, logBroadcaster := range fm.logBroadcasters {
+		logBroadcaster.Start()
+	}
 
 	return err
 }
@@ -138,7 +158,9 @@ func (fm *concreteFluxMonitor) Stop() {
 		return
 	}
 
-	fm.logBroadcaster.Stop()
+	for _, logBroadcaster := range fm.logBroadcasters {
+		logBroadcaster.Stop()
+	}
 	close(fm.chStop)
 	if fm.started {
 		fm.started = false
@@ -177,6 +199,20 @@ func (fm *concreteFluxMonitor) serveInternalRequests() {
 			}
 			delete(jobMap, jobID)
 
+		case entry := <-fm.chPause:
+			checkers, ok := jobMap[entry.jobID]
+			if !ok {
+				logger.Debugf("job '%s' is missing from the flux monitor", entry.jobID.String())
+				continue
+			}
+			for _, checker := range checkers {
+				if entry.paused {
+					checker.Pause()
+				} else {
+					checker.Resume()
+				}
+			}
+
 		case <-fm.chStop:
 			for _, checkers := range jobMap {
 				for _, checker := range checkers {
@@ -235,6 +271,27 @@ func (fm *concreteFluxMonitor) RemoveJob(id *models.ID) {
 	fm.chRemove <- *id
 }
 
+// PauseJob stops the checkers of the job from polling and submitting answers,
+// without removing them.
+func (fm *concreteFluxMonitor) PauseJob(id *models.ID) {
+	fm.setJobPaused(id, true)
+}
+
+// ResumeJob lets the paused checkers of the job poll and submit answers again.
+func (fm *concreteFluxMonitor) ResumeJob(id *models.ID) {
+	fm.setJobPaused(id, false)
+}
+
+func (fm *concreteFluxMonitor) setJobPaused(id *models.ID, paused bool) {
+	if fm.disabled {
+		return
+	} else if id == nil {
+		logger.Warn("nil job ID passed to FluxMonitor#PauseJob or FluxMonitor#ResumeJob")
+		return
+	}
+	fm.chPause <- pauseEntry{*id, paused}
+}
+
 // DeviationCheckerFactory holds the New method needed to create a new instance
 // of a DeviationChecker.
 type DeviationCheckerFactory interface {
@@ -242,8 +299,8 @@ type DeviationCheckerFactory interface {
 }
 
 type pollingDeviationCheckerFactory struct {
-	store          *store.Store
-	logBroadcaster eth.LogBroadcaster
+	store           *store.Store
+	logBroadcasters logBroadcasters
 }
 
 func (f pollingDeviationCheckerFactory) New(
@@ -260,40 +317,133 @@ func (f pollingDeviationCheckerFactory) New(
 		return nil, fmt.Errorf("pollTimer.period must be equal or greater than %s", minimumPollingInterval)
 	}
 
-	urls, err := ExtractFeedURLs(initr.Feeds, orm)
+	fetcher, err := f.newFetcher(initr, orm, timeout)
 	if err != nil {
 		return nil, err
 	}
 
-	fetcher, err := newMedianFetcherFromURLs(
-		timeout,
-		initr.RequestData.String(),
-		urls)
+	chain, err := f.store.Chain(initr.ChainID.ToInt())
 	if err != nil {
 		return nil, err
 	}
+	logBroadcaster := f.logBroadcasters[chain.ID.String()]
 
-	f.logBroadcaster.AddDependents(1)
-	fluxAggregator, err := contracts.NewFluxAggregator(initr.Address, f.store.TxManager, f.logBroadcaster)
+	logBroadcaster.AddDependents(1)
+	version, err := aggregatorVersion(initr, chain.TxManager, orm)
+	if err != nil {
+		logger.Warnw("Unable to detect aggregator version, driving it as a FluxAggregator until it is",
+			"initr", initr.ID, "address", initr.Address.Hex(), "error", err)
+		version = contracts.AggregatorVersionFlux
+	}
+	account, err := f.store.KeyStore.GetFirstAccount()
+	if err != nil && version == contracts.AggregatorVersionWhitelisted {
+		return nil, errors.Wrap(err, "unable to read the answers of a WhitelistedAggregator without a node account")
+	}
+	fluxAggregator, err := contracts.NewAggregator(version, initr.Address, account.Address, chain.TxManager, logBroadcaster)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewPollingDeviationChecker(
+	if initr.WatchOnly {
+		return NewWatchdog(fluxAggregator, initr, fetcher, chain.Config, func() { logBroadcaster.DependentReady() }), nil
+	}
+
+	checker, err := NewPollingDeviationChecker(
 		f.store,
 		fluxAggregator,
 		initr,
 		minJobPayment,
 		runManager,
 		fetcher,
-		func() { f.logBroadcaster.DependentReady() },
+		func() { logBroadcaster.DependentReady() },
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if feedAddress := chain.Config.FluxMonitorLinkEthFeed(); feedAddress != nil {
+		linkEthFeed, err := contracts.NewFluxAggregator(*feedAddress, chain.TxManager, logBroadcaster)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to connect to the LINK/ETH feed")
+		}
+		checker.costEstimator = newSubmissionCostEstimator(linkEthFeed, chain.Config)
+	}
+	return checker, nil
 }
 
-// ExtractFeedURLs extracts a list of url.URLs from the feeds parameter of the initiator params
-func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
+// aggregatorVersion returns the aggregator version of the initiator,
+// detecting and recording it if job creation could not.
+func aggregatorVersion(initr models.Initiator, txManager store.TxManager, orm *orm.ORM) (contracts.AggregatorVersion, error) {
+	if initr.AggregatorVersion != "" {
+		return contracts.AggregatorVersion(initr.AggregatorVersion), nil
+	}
+	version, err := contracts.DetectAggregatorVersion(txManager, initr.Address)
+	if err != nil {
+		return "", err
+	}
+	return version, orm.SetAggregatorVersion(&initr, string(version))
+}
+
+// DetectAggregatorVersions sets the aggregator version of the flux monitor
+// initiators of the job which do not name one, by asking their contracts.
+// Versions which cannot be detected are left unset, to be detected again when
+// the job is added to the flux monitor.
+func DetectAggregatorVersions(store *store.Store, job *models.JobSpec) error {
+	for i, initr := range job.Initiators {
+		if initr.Type != models.InitiatorFluxMonitor || initr.AggregatorVersion != "" {
+			continue
+		}
+		chain, err := store.Chain(initr.ChainID.ToInt())
+		if err != nil {
+			return err
+		}
+		version, err := contracts.DetectAggregatorVersion(chain.TxManager, initr.Address)
+		if err != nil {
+			logger.Warnw("Unable to detect aggregator version",
+				"address", initr.Address.Hex(), "error", err)
+			continue
+		}
+		job.Initiators[i].AggregatorVersion = string(version)
+	}
+	return nil
+}
+
+// newFetcher returns the median of the initiator's feeds, or runs the
+// off-chain tasks of its job if it has none.
+func (f pollingDeviationCheckerFactory) newFetcher(
+	initr models.Initiator,
+	orm *orm.ORM,
+	timeout models.Duration,
+) (Fetcher, error) {
+	if initr.ComputesAnswerFromTasks() {
+		job, err := orm.FindJob(initr.JobSpecID)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load the tasks computing the answer")
+		}
+		return newPipelineFetcher(f.store, adapters.OffChainTasks(job.Tasks), initr.RequestData)
+	}
+
+	feeds, err := ExtractFeeds(initr.Feeds, orm)
+	if err != nil {
+		return nil, err
+	}
+	return newMedianFetcherFromFeeds(timeout, initr.RequestData.String(), feeds, initr.MinResponses, initr.OutlierThreshold)
+}
+
+// Feed is a source of answers listed in the feeds parameter of the initiator
+// params.
+type Feed struct {
+	URL *url.URL
+	// MaxAge is how old the answers of the feed may be, if set.
+	MaxAge time.Duration
+}
+
+// ExtractFeeds extracts the list of feeds from the feeds parameter of the
+// initiator params. Each feed is either a URL, or an object naming a bridge or
+// holding a url, with an optional maxAge, e.g. {"bridge":"name","maxAge":"5m"}.
+func ExtractFeeds(feeds models.Feeds, orm *orm.ORM) ([]Feed, error) {
 	var feedsData []interface{}
-	var urls []*url.URL
+	var result []Feed
 
 	err := json.Unmarshal(feeds.Bytes(), &feedsData)
 	if err != nil {
@@ -301,15 +451,24 @@ func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
 	}
 
 	for _, entry := range feedsData {
-		var bridgeURL *url.URL
+		var feedURL *url.URL
+		var maxAge time.Duration
 		var err error
 
 		switch feed := entry.(type) {
 		case string: // feed url - ex: "http://example.com"
-			bridgeURL, err = url.ParseRequestURI(feed)
+			feedURL, err = url.ParseRequestURI(feed)
 		case map[string]interface{}: // named feed - ex: {"bridge": "bridgeName"}
-			bridgeName := feed["bridge"].(string)
-			bridgeURL, err = GetBridgeURLFromName(bridgeName, orm) // XXX: currently an n query
+			if bridgeName, ok := feed["bridge"].(string); ok {
+				feedURL, err = GetBridgeURLFromName(bridgeName, orm) // XXX: currently an n query
+			} else if rawURL, ok := feed["url"].(string); ok {
+				feedURL, err = url.ParseRequestURI(rawURL)
+			} else {
+				err = errors.New("feed object has neither a bridge nor a url")
+			}
+			if rawMaxAge, ok := feed["maxAge"].(string); ok && err == nil {
+				maxAge, err = time.ParseDuration(rawMaxAge)
+			}
 		default:
 			err = errors.New("unable to extract feed URLs from json")
 		}
@@ -317,9 +476,23 @@ func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
 		if err != nil {
 			return nil, err
 		}
-		urls = append(urls, bridgeURL)
+		result = append(result, Feed{URL: feedURL, MaxAge: maxAge})
+	}
+
+	return result, nil
+}
+
+// ExtractFeedURLs extracts a list of url.URLs from the feeds parameter of the initiator params
+func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
+	extracted, err := ExtractFeeds(feeds, orm)
+	if err != nil {
+		return nil, err
 	}
 
+	var urls []*url.URL
+	for _, feed := range extracted {
+		urls = append(urls, feed.URL)
+	}
 	return urls, nil
 }
 
@@ -339,6 +512,8 @@ func GetBridgeURLFromName(name string, orm *orm.ORM) (*url.URL, error) {
 type DeviationChecker interface {
 	Start()
 	Stop()
+	Pause()
+	Resume()
 }
 
 // PollingDeviationChecker polls external price adapters via HTTP to check for price swings.
@@ -347,6 +522,8 @@ type PollingDeviationChecker struct {
 	fluxAggregator contracts.FluxAggregator
 	runManager     RunManager
 	fetcher        Fetcher
+	// costEstimator skips unprofitable submissions when set.
+	costEstimator *submissionCostEstimator
 
 	initr         models.Initiator
 	minJobPayment *assets.Link
@@ -354,12 +531,17 @@ type PollingDeviationChecker struct {
 	precision     int32
 
 	connected                  *abool.AtomicBool
+	paused                     *abool.AtomicBool
+	chResume                   chan struct{}
 	backlog                    *utils.BoundedPriorityQueue
 	chProcessLogs              chan struct{}
 	reportableRoundID          *big.Int
 	mostRecentSubmittedRoundID uint64
 	pollTicker                 <-chan time.Time
 	idleTimer                  <-chan time.Time
+	drumbeatSchedule           cron.Schedule
+	drumbeat                   <-chan time.Time
+	nextDrumbeat               time.Time
 	roundTimer                 <-chan time.Time
 
 	readyForLogs func()
@@ -385,7 +567,7 @@ func NewPollingDeviationChecker(
 	fetcher Fetcher,
 	readyForLogs func(),
 ) (*PollingDeviationChecker, error) {
-	return &PollingDeviationChecker{
+	checker := &PollingDeviationChecker{
 		readyForLogs:   readyForLogs,
 		store:          store,
 		fluxAggregator: fluxAggregator,
@@ -399,6 +581,8 @@ func NewPollingDeviationChecker(
 		idleTimer:      nil,
 		roundTimer:     nil,
 		connected:      abool.New(),
+		paused:         abool.NewBool(initr.Paused),
+		chResume:       make(chan struct{}, 1),
 		backlog: utils.NewBoundedPriorityQueue(map[uint]uint{
 			// We want reconnecting nodes to be able to submit to a round
 			// that hasn't hit maxAnswers yet, as well as the newest round.
@@ -408,7 +592,16 @@ func NewPollingDeviationChecker(
 		chProcessLogs: make(chan struct{}, 1),
 		chStop:        make(chan struct{}),
 		waitOnStop:    make(chan struct{}),
-	}, nil
+	}
+
+	if initr.Drumbeat.Enabled() {
+		schedule, err := models.CronParser.Parse(initr.Drumbeat.Schedule.String())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid drumbeat schedule")
+		}
+		checker.drumbeatSchedule = schedule
+	}
+	return checker, nil
 }
 
 const (
@@ -432,6 +625,29 @@ func (p *PollingDeviationChecker) Stop() {
 	<-p.waitOnStop
 }
 
+// Pause stops this instance from polling and submitting answers, while it
+// keeps tracking the rounds of the aggregator.
+func (p *PollingDeviationChecker) Pause() {
+	logger.Infow("Pausing checker for job",
+		"job", p.initr.JobSpecID.String(),
+		"initr", p.initr.ID)
+	p.paused.Set()
+}
+
+// Resume lets a paused instance poll and submit answers again, starting with
+// an immediate poll.
+func (p *PollingDeviationChecker) Resume() {
+	logger.Infow("Resuming checker for job",
+		"job", p.initr.JobSpecID.String(),
+		"initr", p.initr.ID)
+	if p.paused.SetToIf(true, false) {
+		select {
+		case p.chResume <- struct{}{}:
+		default:
+		}
+	}
+}
+
 func (p *PollingDeviationChecker) OnConnect() {
 	logger.Debugw("PollingDeviationChecker connected to Ethereum node",
 		"address", p.initr.Address.Hex(),
@@ -489,7 +705,7 @@ func (p *PollingDeviationChecker) consume() {
 
 	if !p.initr.PollTimer.Disabled {
 		// Try to do an initial poll
-		p.pollIfEligible(DeviationThresholds{Rel: float64(p.initr.Threshold),
+		p.pollIfEligible(models.FluxMonitorTriggerPoll, DeviationThresholds{Rel: float64(p.initr.Threshold),
 			Abs: float64(p.initr.AbsoluteThreshold)})
 
 		ticker := time.NewTicker(p.initr.PollTimer.Period.Duration())
@@ -499,6 +715,7 @@ func (p *PollingDeviationChecker) consume() {
 	if !p.initr.IdleTimer.Disabled {
 		p.idleTimer = time.After(p.initr.IdleTimer.Duration.Duration())
 	}
+	p.resetDrumbeat(time.Now())
 
 	for {
 		select {
@@ -508,6 +725,10 @@ func (p *PollingDeviationChecker) consume() {
 		case <-p.chProcessLogs:
 			p.processLogs()
 
+		case <-p.chResume:
+			p.pollIfEligible(models.FluxMonitorTriggerPoll, DeviationThresholds{Rel: float64(p.initr.Threshold),
+				Abs: float64(p.initr.AbsoluteThreshold)})
+
 		case <-p.pollTicker:
 			logger.Debugw("Poll ticker fired",
 				"pollPeriod", p.initr.PollTimer.Period,
@@ -516,7 +737,7 @@ func (p *PollingDeviationChecker) consume() {
 				"reportableRoundID", p.reportableRoundID,
 				"contract", p.initr.Address.Hex(),
 			)
-			p.pollIfEligible(DeviationThresholds{Rel: float64(p.initr.Threshold),
+			p.pollIfEligible(models.FluxMonitorTriggerPoll, DeviationThresholds{Rel: float64(p.initr.Threshold),
 				Abs: float64(p.initr.AbsoluteThreshold)})
 
 		case <-p.idleTimer:
@@ -527,7 +748,17 @@ func (p *PollingDeviationChecker) consume() {
 				"reportableRoundID", p.reportableRoundID,
 				"contract", p.initr.Address.Hex(),
 			)
-			p.pollIfEligible(DeviationThresholds{Rel: 0, Abs: 0})
+			p.respondToIdleTimer(time.Now())
+
+		case <-p.drumbeat:
+			logger.Debugw("Drumbeat fired",
+				"drumbeat", p.initr.Drumbeat.Schedule,
+				"idleDuration", p.initr.IdleTimer.Duration,
+				"mostRecentSubmittedRoundID", p.mostRecentSubmittedRoundID,
+				"reportableRoundID", p.reportableRoundID,
+				"contract", p.initr.Address.Hex(),
+			)
+			p.respondToDrumbeat(time.Now())
 
 		case <-p.roundTimer:
 			logger.Debugw("Round timeout ticker fired",
@@ -537,7 +768,7 @@ func (p *PollingDeviationChecker) consume() {
 				"reportableRoundID", p.reportableRoundID,
 				"contract", p.initr.Address.Hex(),
 			)
-			p.pollIfEligible(DeviationThresholds{Rel: float64(p.initr.Threshold),
+			p.pollIfEligible(models.FluxMonitorTriggerRoundTimeout, DeviationThresholds{Rel: float64(p.initr.Threshold),
 				Abs: float64(p.initr.AbsoluteThreshold)})
 		}
 	}
@@ -642,6 +873,11 @@ func (p *PollingDeviationChecker) respondToNewRoundLog(log contracts.LogNewRound
 	jobSpecID := p.initr.JobSpecID.String()
 	promSetBigInt(promFMSeenRound.WithLabelValues(jobSpecID), log.RoundId)
 
+	if p.paused.IsSet() {
+		logger.Infow("Ignoring new round request: paused", p.loggerFieldsForNewRound(log)...)
+		return
+	}
+
 	// Ignore rounds we started
 	acct, err := p.store.KeyStore.GetFirstAccount()
 	if err != nil {
@@ -652,30 +888,40 @@ func (p *PollingDeviationChecker) respondToNewRoundLog(log contracts.LogNewRound
 		return
 	}
 
+	round := models.NewFluxMonitorRound(p.initr.JobSpecID, uint32(log.RoundId.Uint64()), models.FluxMonitorTriggerNewRound)
+	defer p.recordRound(round)
+
 	// It's possible for RoundState() to return a higher round ID than the one in the NewRound log
 	// (for example, if a large set of logs are delayed and arrive all at once).  We trust the value
 	// from RoundState() over the one in the log, and record it as the current ReportableRoundID.
 	roundState, err := p.roundState()
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("Ignoring new round request: error fetching eligibility from contract: %v", err), p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = fmt.Sprintf("error fetching eligibility from contract: %v", err)
 		return
 	}
+	round.Payment = (*assets.Link)(roundState.PaymentAmount)
 
 	err = p.checkEligibilityAndAggregatorFunding(roundState)
 	if errors.Cause(err) == ErrAlreadySubmitted {
 		logger.Infow(fmt.Sprintf("Ignoring new round request: %v, possible chain reorg", err), p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = err.Error()
 		return
 	} else if err != nil {
 		logger.Infow(fmt.Sprintf("Ignoring new round request: %v", err), p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = err.Error()
 		return
 	}
+	round.Eligible = true
 
 	// Ignore old rounds
 	if log.RoundId.Cmp(p.reportableRoundID) < 0 {
 		logger.Infow("Ignoring new round request: new < current", p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = "round is older than the reportable round"
 		return
 	} else if log.RoundId.Uint64() <= p.mostRecentSubmittedRoundID {
 		logger.Infow("Ignoring new round request: already submitted for this round", p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = "already submitted for this round"
 		return
 	}
 
@@ -684,14 +930,28 @@ func (p *PollingDeviationChecker) respondToNewRoundLog(log contracts.LogNewRound
 	polledAnswer, err := p.fetcher.Fetch()
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("unable to fetch median price: %v", err), p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = fmt.Sprintf("unable to fetch answer: %v", err)
+		return
+	}
+	round.Answer = decimal.NullDecimal{Decimal: polledAnswer, Valid: true}
+
+	err = p.checkProfitability(round, roundState.PaymentAmount)
+	if err != nil {
+		logger.Infow(fmt.Sprintf("Ignoring new round request: %v", err), p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = err.Error()
 		return
 	}
 
-	err = p.createJobRun(polledAnswer, p.reportableRoundID)
+	logger.Infow("Submitting answer to new round",
+		append(p.loggerFieldsForNewRound(log), "polledAnswer", polledAnswer, "droppedSources", droppedSources(p.fetcher))...)
+
+	run, err := p.createJobRun(polledAnswer, p.reportableRoundID)
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("unable to create job run: %v", err), p.loggerFieldsForNewRound(log)...)
+		round.SkipReason = fmt.Sprintf("unable to create job run: %v", err)
 		return
 	}
+	round.Submit(run)
 }
 
 var (
@@ -745,7 +1005,7 @@ type DeviationThresholds struct {
 }
 
 func (p *PollingDeviationChecker) pollIfEligible(
-	thresholds DeviationThresholds) (createdJobRun bool) {
+	trigger models.FluxMonitorTrigger, thresholds DeviationThresholds) (createdJobRun bool) {
 	loggerFields := []interface{}{
 		"jobID", p.initr.JobSpecID,
 		"address", p.initr.InitiatorParams.Address,
@@ -756,32 +1016,48 @@ func (p *PollingDeviationChecker) pollIfEligible(
 	if !p.connected.IsSet() {
 		logger.Warnw("not connected to Ethereum node, skipping poll", loggerFields...)
 		return false
+	} else if p.paused.IsSet() {
+		logger.Debugw("paused, skipping poll", loggerFields...)
+		return false
 	}
 
+	round := models.NewFluxMonitorRound(p.initr.JobSpecID, 0, trigger)
+	defer p.recordRound(round)
+
 	roundState, err := p.roundState()
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("unable to determine eligibility to submit from FluxAggregator contract: %v", err), loggerFields...)
+		round.SkipReason = fmt.Sprintf("error fetching eligibility from contract: %v", err)
 		return false
 	}
 	loggerFields = append(loggerFields, "reportableRound", roundState.ReportableRoundID)
+	round.RoundID = roundState.ReportableRoundID
+	round.Payment = (*assets.Link)(roundState.PaymentAmount)
 
 	err = p.checkEligibilityAndAggregatorFunding(roundState)
 	if errors.Cause(err) == ErrAlreadySubmitted {
 		logger.Infow(fmt.Sprintf("skipping poll: %v, tx is pending", err), loggerFields...)
+		round.SkipReason = err.Error()
 		return false
 	} else if err != nil {
 		logger.Infow(fmt.Sprintf("skipping poll: %v", err), loggerFields...)
+		round.SkipReason = err.Error()
 		return false
 	}
+	round.Eligible = true
 
 	polledAnswer, err := p.fetcher.Fetch()
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("can't fetch answer: %v", err), loggerFields...)
+		round.SkipReason = fmt.Sprintf("unable to fetch answer: %v", err)
 		return false
 	}
 
 	jobSpecID := p.initr.JobSpecID.String()
 	latestAnswer := decimal.NewFromBigInt(roundState.LatestAnswer, -p.precision)
+	round.Answer = decimal.NullDecimal{Decimal: polledAnswer, Valid: true}
+	round.LatestAnswer = decimal.NullDecimal{Decimal: latestAnswer, Valid: true}
+	round.Deviation = relativeDeviation(latestAnswer, polledAnswer)
 
 	promSetDecimal(promFMSeenValue.WithLabelValues(jobSpecID), polledAnswer)
 	loggerFields = append(loggerFields,
@@ -791,20 +1067,31 @@ func (p *PollingDeviationChecker) pollIfEligible(
 	if roundState.ReportableRoundID > 1 && !OutsideDeviation(latestAnswer,
 		polledAnswer, thresholds) {
 		logger.Debugw("deviation < threshold, not submitting", loggerFields...)
+		round.SkipReason = "deviation < threshold"
 		return false
 	}
 
+	err = p.checkProfitability(round, roundState.PaymentAmount)
+	if err != nil {
+		logger.Infow(fmt.Sprintf("skipping poll: %v", err), loggerFields...)
+		round.SkipReason = err.Error()
+		return false
+	}
+
+	loggerFields = append(loggerFields, "droppedSources", droppedSources(p.fetcher))
 	if roundState.ReportableRoundID > 1 {
 		logger.Infow("deviation > threshold, starting new round", loggerFields...)
 	} else {
 		logger.Infow("starting first round", loggerFields...)
 	}
 
-	err = p.createJobRun(polledAnswer, p.reportableRoundID)
+	run, err := p.createJobRun(polledAnswer, p.reportableRoundID)
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("can't create job run: %v", err), loggerFields...)
+		round.SkipReason = fmt.Sprintf("unable to create job run: %v", err)
 		return false
 	}
+	round.Submit(run)
 
 	promSetDecimal(promFMReportedValue.WithLabelValues(jobSpecID), polledAnswer)
 	promSetBigInt(promFMReportedRound.WithLabelValues(jobSpecID), p.reportableRoundID)
@@ -878,6 +1165,41 @@ func (p *PollingDeviationChecker) resetIdleTicker(roundStartedAtUTC uint64) {
 	logger.Debugw("resetting idleTimer", loggerFields...)
 }
 
+// respondToIdleTimer forces a submission, unless the drumbeat is due before
+// the idle timer would fire again: the idle timer then defers to the drumbeat,
+// so that they do not start two rounds in a row.
+//
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (p *PollingDeviationChecker) respondToIdleTimer(now time.Time) bool {
+	if p.drumbeatSchedule != nil && p.nextDrumbeat.Sub(now) < p.initr.IdleTimer.Duration.Duration() {
+		logger.Debugw("idleTimer deferring to the drumbeat", p.loggerFields("nextDrumbeat", p.nextDrumbeat)...)
+		return false
+	}
+	return p.pollIfEligible(models.FluxMonitorTriggerIdle, DeviationThresholds{Rel: 0, Abs: 0})
+}
+
+// respondToDrumbeat forces a submission, and restarts the idle timer so that
+// it does not force another one right after.
+//
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (p *PollingDeviationChecker) respondToDrumbeat(now time.Time) bool {
+	p.resetDrumbeat(now)
+	if !p.initr.IdleTimer.Disabled {
+		p.idleTimer = time.After(p.initr.IdleTimer.Duration.Duration())
+	}
+	return p.pollIfEligible(models.FluxMonitorTriggerDrumbeat, DeviationThresholds{Rel: 0, Abs: 0})
+}
+
+// resetDrumbeat schedules the next tick of the drumbeat, if any.
+func (p *PollingDeviationChecker) resetDrumbeat(now time.Time) {
+	if p.drumbeatSchedule == nil {
+		return
+	}
+	p.nextDrumbeat = p.drumbeatSchedule.Next(now)
+	p.drumbeat = time.After(p.nextDrumbeat.Sub(now))
+	logger.Debugw("resetting drumbeat", p.loggerFields("nextDrumbeat", p.nextDrumbeat)...)
+}
+
 // jobRunRequest is the request used to trigger a Job Run by the Flux Monitor.
 type jobRunRequest struct {
 	Result           decimal.Decimal `json:"result"`
@@ -886,15 +1208,15 @@ type jobRunRequest struct {
 	DataPrefix       string          `json:"dataPrefix"`
 }
 
-func (p *PollingDeviationChecker) createJobRun(polledAnswer decimal.Decimal, nextRound *big.Int) error {
+func (p *PollingDeviationChecker) createJobRun(polledAnswer decimal.Decimal, nextRound *big.Int) (*models.JobRun, error) {
 	methodID, err := p.fluxAggregator.GetMethodID("submit")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	nextRoundData, err := utils.EVMWordBigInt(nextRound)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	payload, err := json.Marshal(jobRunRequest{
@@ -904,22 +1226,31 @@ func (p *PollingDeviationChecker) createJobRun(polledAnswer decimal.Decimal, nex
 		DataPrefix:       hexutil.Encode(nextRoundData),
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to encode Job Run request in JSON")
+		return nil, errors.Wrapf(err, "unable to encode Job Run request in JSON")
 	}
 	runData, err := models.ParseJSON(payload)
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("unable to start chainlink run with payload %s", payload))
+		return nil, errors.Wrap(err, fmt.Sprintf("unable to start chainlink run with payload %s", payload))
 	}
 	runRequest := models.NewRunRequest(runData)
 
-	_, err = p.runManager.Create(p.initr.JobSpecID, &p.initr, nil, runRequest)
+	run, err := p.runManager.Create(p.initr.JobSpecID, &p.initr, nil, runRequest)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	p.mostRecentSubmittedRoundID = nextRound.Uint64()
 
-	return nil
+	return run, nil
+}
+
+// recordRound persists the decision taken about a round, so that operators
+// can audit why the feed did or did not update. Every poll is recorded, and
+// the store reaper prunes records older than FLUX_MONITOR_ROUNDS_RETENTION.
+func (p *PollingDeviationChecker) recordRound(round *models.FluxMonitorRound) {
+	if err := p.store.CreateFluxMonitorRound(round); err != nil {
+		logger.Errorw(fmt.Sprintf("unable to record flux monitor round: %v", err), p.loggerFields()...)
+	}
 }
 
 func (p *PollingDeviationChecker) loggerFields(added ...interface{}) []interface{} {
@@ -961,6 +1292,12 @@ func (p *PollingDeviationChecker) JobID() *models.ID {
 // OutsideDeviation checks whether the next price is outside the threshold.
 // If both thresholds are zero (default value), always returns true.
 func OutsideDeviation(curAnswer, nextAnswer decimal.Decimal,
+	thresholds DeviationThresholds) bool {
+	return outsideDeviation(logger.GetLogger(), curAnswer, nextAnswer, thresholds)
+}
+
+// outsideDeviation is OutsideDeviation logging its decision to log.
+func outsideDeviation(log *logger.Logger, curAnswer, nextAnswer decimal.Decimal,
 	thresholds DeviationThresholds) bool {
 	loggerFields := []interface{}{
 		"threshold", thresholds.Rel,
@@ -970,7 +1307,7 @@ func OutsideDeviation(curAnswer, nextAnswer decimal.Decimal,
 	}
 
 	if thresholds.Rel == 0 && thresholds.Abs == 0 {
-		logger.Debugw(
+		log.Debugw(
 			"Deviation thresholds both zero; short-circuiting deviation checker to "+
 				"true, regardless of feed values", loggerFields...)
 		return true
@@ -979,18 +1316,18 @@ func OutsideDeviation(curAnswer, nextAnswer decimal.Decimal,
 	loggerFields = append(loggerFields, "absoluteDeviation", diff)
 
 	if !diff.GreaterThan(decimal.NewFromFloat(thresholds.Abs)) {
-		logger.Debugw("Absolute deviation threshold not met", loggerFields...)
+		log.Debugw("Absolute deviation threshold not met", loggerFields...)
 		return false
 	}
 
 	if curAnswer.IsZero() {
 		if nextAnswer.IsZero() {
-			logger.Debugw("Relative deviation is undefined; can't satisfy threshold",
+			log.Debugw("Relative deviation is undefined; can't satisfy threshold",
 				loggerFields...)
 			return false
 		}
 
-		logger.Infow("Relative-deviation is ᪲; threshold met", loggerFields...)
+		log.Infow("Relative-deviation is ᪲; threshold met", loggerFields...)
 		return true
 	}
 
@@ -1000,13 +1337,23 @@ func OutsideDeviation(curAnswer, nextAnswer decimal.Decimal,
 	loggerFields = append(loggerFields, "percentage", percentage)
 
 	if percentage.LessThan(decimal.NewFromFloat(thresholds.Rel)) {
-		logger.Debugw("Relative deviation threshold not met", loggerFields...)
+		log.Debugw("Relative deviation threshold not met", loggerFields...)
 		return false
 	}
-	logger.Infow("Relative and absolute deviation thresholds both met", loggerFields...)
+	log.Infow("Relative and absolute deviation thresholds both met", loggerFields...)
 	return true
 }
 
+// relativeDeviation returns the change from curAnswer to nextAnswer as a
+// percentage of curAnswer, which is undefined if it is zero.
+func relativeDeviation(curAnswer, nextAnswer decimal.Decimal) decimal.NullDecimal {
+	if curAnswer.IsZero() {
+		return decimal.NullDecimal{}
+	}
+	diff := curAnswer.Sub(nextAnswer).Abs()
+	return decimal.NullDecimal{Decimal: diff.Div(curAnswer.Abs()).Mul(decimal.NewFromInt(100)), Valid: true}
+}
+
 // MakeIdleTimer checks the log timestamp and calculates the idle time
 // from that.
 //
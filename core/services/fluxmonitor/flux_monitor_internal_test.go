@@ -9,7 +9,9 @@ import (
 
 func (fm *concreteFluxMonitor) MockLogBroadcaster() *mockLogBroadcaster {
 	mock := mockLogBroadcaster{}
-	fm.logBroadcaster = &mock
+	for chainID := range fm.logBroadcasters {
+		fm.logBroadcasters[chainID] = &mock
+	}
 	return &mock
 }
 
@@ -21,7 +23,7 @@ type mockLogBroadcaster struct {
 func (mlb *mockLogBroadcaster) Start() {
 	mlb.Started = true
 }
-func (mlb *mockLogBroadcaster) Register(common.Address, eth.LogListener) bool {
+func (mlb *mockLogBroadcaster) Register(common.Address, eth.LogListener, ...common.Hash) bool {
 	return false
 }
 func (mlb *mockLogBroadcaster) Unregister(common.Address, eth.LogListener) {}
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	faw "github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/link_token_interface"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -225,6 +226,29 @@ func submitAnswer(t *testing.T, p answerParams) {
 	checkSubmission(t, p, cb.Int64(), 0)
 }
 
+func TestDetectAggregatorVersion_SimulatedBlockchain(t *testing.T) {
+	var description [32]byte
+	copy(description[:], "exactly thirty-two characters!!!")
+	fa := deployFluxAggregator(t, fee, 1, 8, description)
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	app, cleanup := cltest.NewApplicationWithConfigAndKeyOnSimulatedBlockchain(t,
+		config, fa.backend)
+	defer cleanup()
+
+	version, err := contracts.DetectAggregatorVersion(app.Store.TxManager,
+		fa.aggregatorContractAddress)
+	require.NoError(t, err)
+	assert.Equal(t, contracts.AggregatorVersionFlux, version,
+		"a FluxAggregator does not answer whitelistEnabled()")
+
+	version, err = contracts.DetectAggregatorVersion(app.Store.TxManager,
+		cltest.NewAddress())
+	assert.Error(t, err, "there is no aggregator to detect at an empty address")
+	assert.Empty(t, version)
+}
+
 type maliciousFluxMonitor interface {
 	CreateJob(t *testing.T, jobSpecId *models.ID,
 		polledAnswer decimal.Decimal, nextRound *big.Int) error
@@ -290,6 +314,8 @@ func TestFluxMonitorAntiSpamLogic(t *testing.T) {
 	initr.InitiatorParams.PollTimer.Period = models.MustMakeDuration(timeout)
 	initr.InitiatorParams.Address = fa.aggregatorContractAddress
 	j := cltest.CreateJobSpecViaWeb(t, app, job)
+	assert.Equal(t, string(contracts.AggregatorVersionFlux), j.Initiators[0].AggregatorVersion,
+		"a FluxAggregator does not answer whitelistEnabled()")
 	jrs := cltest.WaitForRuns(t, j, app.Store, 1) // Submit answer from
 	reportedPrice := jrs[0].RunRequest.RequestParams.Get("result").String()
 	assert.Equal(t, reportedPrice, fmt.Sprintf("%d", reportPrice),
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"net/http"
 	"net/url"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/eth"
@@ -128,6 +130,41 @@ func TestConcreteFluxMonitor_AddJobRemoveJob(t *testing.T) {
 		dc.AssertExpectations(t)
 	})
 
+	t.Run("pauses and resumes the DeviationCheckers of a job", func(t *testing.T) {
+		job := cltest.NewJobWithFluxMonitorInitiator()
+		runManager := new(mocks.RunManager)
+		started := make(chan struct{}, 1)
+		paused := make(chan struct{})
+		resumed := make(chan struct{})
+
+		dc := new(mocks.DeviationChecker)
+		dc.On("Start").Return().Run(func(mock.Arguments) { started <- struct{}{} })
+		dc.On("Pause").Return().Run(func(mock.Arguments) { paused <- struct{}{} })
+		dc.On("Resume").Return().Run(func(mock.Arguments) { resumed <- struct{}{} })
+		dc.On("Stop").Return()
+
+		checkerFactory := new(mocks.DeviationCheckerFactory)
+		checkerFactory.On("New", job.Initiators[0], mock.Anything, runManager, store.ORM, store.Config.DefaultHTTPTimeout()).Return(dc, nil)
+		fm := fluxmonitor.New(store, runManager)
+		fluxmonitor.ExportedSetCheckerFactory(fm, checkerFactory)
+		require.NoError(t, fm.Start())
+		defer fm.Stop()
+
+		require.NoError(t, fm.AddJob(job))
+		cltest.CallbackOrTimeout(t, "deviation checker started", func() {
+			<-started
+		})
+
+		go fm.PauseJob(job.ID)
+		cltest.CallbackOrTimeout(t, "deviation checker paused", func() {
+			<-paused
+		})
+		go fm.ResumeJob(job.ID)
+		cltest.CallbackOrTimeout(t, "deviation checker resumed", func() {
+			<-resumed
+		})
+	})
+
 	t.Run("does not error or attempt to start a DeviationChecker when receiving a non-Flux Monitor job", func(t *testing.T) {
 		job := cltest.NewJobWithRunLogInitiator()
 		runManager := new(mocks.RunManager)
@@ -319,6 +356,18 @@ func TestPollingDeviationChecker_PollIfEligible(t *testing.T) {
 				fluxAggregator.AssertExpectations(t)
 				fetcher.AssertExpectations(t)
 				rm.AssertExpectations(t)
+
+				rounds, _, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+				require.NoError(t, err)
+				if !test.connected {
+					assert.Empty(t, rounds)
+					return
+				}
+				require.Len(t, rounds, 1)
+				assert.Equal(t, uint32(reportableRoundID), rounds[0].RoundID)
+				assert.Equal(t, test.expectedToPoll, rounds[0].Answer.Valid)
+				assert.Equal(t, test.expectedToSubmit, rounds[0].Submitted)
+				assert.Equal(t, test.expectedToSubmit, rounds[0].SkipReason == "")
 			})
 		}
 	}
@@ -1112,6 +1161,87 @@ func TestExtractFeedURLs(t *testing.T) {
 	}
 }
 
+func TestPollingDeviationChecker_Paused(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.Paused = true
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(store, fluxAggregator, initr, nil, rm, fetcher, func() {})
+	require.NoError(t, err)
+	checker.OnConnect()
+
+	// Neither polls nor responds to new rounds while paused
+	assert.False(t, checker.ExportedPollIfEligible(0, 0))
+	checker.ExportedRespondToNewRoundLog(&contracts.LogNewRound{RoundId: big.NewInt(2), StartedAt: big.NewInt(0)})
+	fluxAggregator.AssertNotCalled(t, "RoundState", mock.Anything)
+	fetcher.AssertNotCalled(t, "Fetch")
+
+	rounds, _, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, rounds)
+
+	// Polls again once resumed
+	fluxAggregator.On("RoundState", nodeAddr).Return(contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 2,
+		EligibleToSubmit:  false,
+	}, nil).Once()
+	checker.Resume()
+	assert.False(t, checker.ExportedPollIfEligible(0, 0))
+	fluxAggregator.AssertExpectations(t)
+}
+
+func TestPollingDeviationChecker_RecordsEveryPoll(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(store, fluxAggregator, initr, nil, rm, fetcher, func() {})
+	require.NoError(t, err)
+	checker.OnConnect()
+
+	// Repeated polls skipping the same round for the same reason are each recorded
+	fluxAggregator.On("RoundState", nodeAddr).Return(contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 2,
+		EligibleToSubmit:  false,
+	}, nil).Times(3)
+	for i := 0; i < 3; i++ {
+		assert.False(t, checker.ExportedPollIfEligible(0, 0))
+	}
+	rounds, _, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, rounds, 3)
+	for _, round := range rounds {
+		assert.Equal(t, fluxmonitor.ErrNotEligible.Error(), round.SkipReason)
+	}
+
+	// And so is a new round
+	fluxAggregator.On("RoundState", nodeAddr).Return(contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 3,
+		EligibleToSubmit:  false,
+	}, nil).Once()
+	assert.False(t, checker.ExportedPollIfEligible(0, 0))
+	rounds, _, err = store.FluxMonitorRoundsFor(job.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, rounds, 4)
+
+	fluxAggregator.AssertExpectations(t)
+}
+
 func TestPollingDeviationChecker_SufficientPayment(t *testing.T) {
 	t.Parallel()
 
@@ -1212,6 +1342,145 @@ func TestPollingDeviationChecker_SufficientFunds(t *testing.T) {
 	}
 }
 
+func TestPollingDeviationChecker_SkipsUnprofitableSubmissions(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	// 200,000 gas at 20 gwei is 0.004 ETH, or 1 LINK at 0.004 ETH per LINK
+	store.Config.Set(orm.EnvVarName("FluxMonitorSubmissionGas"), 200000)
+	store.Config.Set(orm.EnvVarName("EthGasPriceDefault"), 20000000000)
+	store.Config.Set(orm.EnvVarName("MinimumContractPayment"), 0)
+	weiPerLink := big.NewInt(4000000000000000)
+	oneLink := assets.NewLink(1000000000000000000)
+
+	tests := []struct {
+		name              string
+		trigger           models.FluxMonitorTrigger
+		reportableRoundID uint32
+		payment           int64
+		feedErr           error
+		expectedToSubmit  bool
+		expectedCost      *assets.Link
+	}{
+		{"payment above cost", models.FluxMonitorTriggerPoll, 2, 2000000000000000000, nil, true, oneLink},
+		{"payment equal to cost", models.FluxMonitorTriggerPoll, 2, 1000000000000000000, nil, true, oneLink},
+		{"payment below cost", models.FluxMonitorTriggerPoll, 2, 500000000000000000, nil, false, oneLink},
+		{"payment below cost on round timeout", models.FluxMonitorTriggerRoundTimeout, 2, 500000000000000000, nil, false, oneLink},
+		{"payment below cost on idle", models.FluxMonitorTriggerIdle, 2, 500000000000000000, nil, true, oneLink},
+		{"payment below cost on drumbeat", models.FluxMonitorTriggerDrumbeat, 2, 500000000000000000, nil, true, oneLink},
+		{"payment below cost in first round", models.FluxMonitorTriggerPoll, 1, 500000000000000000, nil, true, oneLink},
+		{"feed unavailable", models.FluxMonitorTriggerPoll, 2, 500000000000000000, errors.New("no answer"), true, nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rm := new(mocks.RunManager)
+			fetcher := new(mocks.Fetcher)
+			fluxAggregator := new(mocks.FluxAggregator)
+			linkEthFeed := new(mocks.FluxAggregator)
+
+			job := cltest.NewJobWithFluxMonitorInitiator()
+			initr := job.Initiators[0]
+
+			payment := big.NewInt(test.payment)
+			fluxAggregator.On("RoundState", nodeAddr).Return(contracts.FluxAggregatorRoundState{
+				ReportableRoundID: test.reportableRoundID,
+				EligibleToSubmit:  true,
+				LatestAnswer:      big.NewInt(100),
+				AvailableFunds:    new(big.Int).Mul(payment, big.NewInt(1000)),
+				PaymentAmount:     payment,
+				OracleCount:       oracleCount,
+			}, nil)
+			fetcher.On("Fetch").Return(decimal.NewFromInt(200), nil)
+			if test.feedErr != nil {
+				linkEthFeed.On("LatestAnswer").Return(nil, test.feedErr)
+			} else {
+				linkEthFeed.On("LatestAnswer").Return(weiPerLink, nil)
+			}
+			if test.expectedToSubmit {
+				run := cltest.NewJobRun(job)
+				rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything).Return(&run, nil)
+				fluxAggregator.On("GetMethodID", "submit").Return(submitSelector, nil)
+			}
+
+			checker, err := fluxmonitor.NewPollingDeviationChecker(store, fluxAggregator, initr, nil, rm, fetcher, func() {})
+			require.NoError(t, err)
+			checker.ExportedSetCostEstimator(linkEthFeed, store.Config)
+			checker.OnConnect()
+
+			assert.Equal(t, test.expectedToSubmit, checker.ExportedPollIfEligibleOn(test.trigger, 0.1, 0.1))
+
+			fluxAggregator.AssertExpectations(t)
+			linkEthFeed.AssertExpectations(t)
+			rm.AssertExpectations(t)
+
+			rounds, _, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+			require.NoError(t, err)
+			require.Len(t, rounds, 1)
+			assert.Equal(t, test.expectedToSubmit, rounds[0].Submitted)
+			assert.Equal(t, test.expectedCost, rounds[0].SubmissionCost)
+			if !test.expectedToSubmit {
+				assert.Contains(t, rounds[0].SkipReason, fluxmonitor.ErrUnprofitable.Error())
+			}
+		})
+	}
+}
+
+func TestPollingDeviationChecker_Drumbeat(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0] // idleTimer 1 minute
+	initr.Drumbeat.Schedule = "CRON_TZ=UTC 0 0 * * *"
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(store, fluxAggregator, initr, nil, rm, fetcher, func() {})
+	require.NoError(t, err)
+	checker.OnConnect()
+
+	// The idle timer defers to a drumbeat due before it would fire again
+	beforeMidnight := time.Date(2020, 6, 20, 23, 59, 30, 0, time.UTC)
+	checker.ExportedResetDrumbeat(beforeMidnight)
+	assert.False(t, checker.ExportedRespondToIdleTimer(beforeMidnight))
+	fluxAggregator.AssertNotCalled(t, "RoundState", mock.Anything)
+
+	// The drumbeat submits whatever the deviation
+	payment := store.Config.MinimumContractPayment().ToInt()
+	fluxAggregator.On("RoundState", nodeAddr).Return(contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 2,
+		EligibleToSubmit:  true,
+		LatestAnswer:      big.NewInt(10000),
+		AvailableFunds:    new(big.Int).Mul(payment, big.NewInt(1000)),
+		PaymentAmount:     payment,
+		OracleCount:       oracleCount,
+	}, nil)
+	fluxAggregator.On("GetMethodID", "submit").Return(submitSelector, nil)
+	fetcher.On("Fetch").Return(decimal.NewFromInt(100), nil)
+	run := cltest.NewJobRun(job)
+	rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything).Return(&run, nil).Once()
+
+	midnight := time.Date(2020, 6, 21, 0, 0, 0, 0, time.UTC)
+	assert.True(t, checker.ExportedRespondToDrumbeat(midnight))
+	rm.AssertExpectations(t)
+
+	rounds, _, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, rounds, 1)
+	assert.Equal(t, models.FluxMonitorTriggerDrumbeat, rounds[0].Trigger)
+	assert.True(t, rounds[0].Submitted)
+
+	// The next drumbeat is a day away, so the idle timer no longer defers
+	assert.False(t, checker.ExportedRespondToIdleTimer(midnight.Add(time.Hour)), "already submitted to round 2")
+	fluxAggregator.AssertNumberOfCalls(t, "RoundState", 2)
+}
+
 func TestFluxMonitor_MakeIdleTimer_RoundStartedAtIsNil(t *testing.T) {
 	t.Parallel()
 
@@ -1397,3 +1666,56 @@ func TestFluxMonitor_ConsumeLogBroadcast_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineFetcher(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	server, serverCleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "GET", `{"price":{"last":"123.456"}}`)
+	defer serverCleanup()
+
+	tasks := []models.TaskSpec{
+		{Type: adapters.TaskTypeHTTPGetWithUnrestrictedNetworkAccess, Params: cltest.JSONFromString(t, `{"get": %q}`, server.URL)},
+		{Type: adapters.TaskTypeJSONParse, Params: cltest.JSONFromString(t, `{"path": ["price", "last"]}`)},
+		{Type: adapters.TaskTypeMultiply, Params: cltest.JSONFromString(t, `{"times": 100}`)},
+		{Type: adapters.TaskTypeEthInt256},
+		{Type: adapters.TaskTypeEthTx},
+	}
+	fetcher, err := fluxmonitor.ExportedNewPipelineFetcher(store, adapters.OffChainTasks(tasks), cltest.JSONFromString(t, `{}`))
+	require.NoError(t, err)
+
+	answer, err := fetcher.Fetch()
+	require.NoError(t, err)
+	assert.Equal(t, "12345.6", answer.String())
+
+	_, err = fluxmonitor.ExportedNewPipelineFetcher(store, adapters.OffChainTasks(tasks[3:]), cltest.JSONFromString(t, `{}`))
+	assert.Error(t, err)
+}
+
+func TestPipelineFetcher_RequestDataParams(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	server, serverCleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "GET", `{"price":{"last":"123.456"}}`)
+	defer serverCleanup()
+
+	tasks := []models.TaskSpec{
+		{Type: adapters.TaskTypeHTTPGetWithUnrestrictedNetworkAccess, Params: cltest.JSONFromString(t, `{"get": %q}`, server.URL)},
+		{Type: adapters.TaskTypeJSONParse, Params: cltest.JSONFromString(t, `{"path": ["price", "last"]}`)},
+		{Type: adapters.TaskTypeMultiply},
+	}
+	fetcher, err := fluxmonitor.ExportedNewPipelineFetcher(store, tasks, cltest.JSONFromString(t, `{"times": 1000}`))
+	require.NoError(t, err)
+
+	answer, err := fetcher.Fetch()
+	require.NoError(t, err)
+	assert.Equal(t, "123456", answer.String())
+
+	tasks[2].Params = cltest.JSONFromString(t, `{"times": 100}`)
+	fetcher, err = fluxmonitor.ExportedNewPipelineFetcher(store, tasks, cltest.JSONFromString(t, `{"times": 1000}`))
+	require.NoError(t, err)
+
+	answer, err = fetcher.Fetch()
+	require.NoError(t, err)
+	assert.Equal(t, "12345.6", answer.String())
+}
@@ -9,8 +9,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -20,13 +23,37 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
 )
 
+func ExportedNewPipelineFetcher(store *store.Store, tasks []models.TaskSpec, requestData models.JSON) (Fetcher, error) {
+	return newPipelineFetcher(store, tasks, requestData)
+}
+
 func ExportedSetCheckerFactory(fm Service, fac DeviationCheckerFactory) {
 	impl := fm.(*concreteFluxMonitor)
 	impl.checkerFactory = fac
 }
 
 func (p *PollingDeviationChecker) ExportedPollIfEligible(threshold, absoluteThreshold float64) bool {
-	return p.pollIfEligible(DeviationThresholds{Rel: threshold, Abs: absoluteThreshold})
+	return p.pollIfEligible(models.FluxMonitorTriggerPoll, DeviationThresholds{Rel: threshold, Abs: absoluteThreshold})
+}
+
+func (p *PollingDeviationChecker) ExportedPollIfEligibleOn(trigger models.FluxMonitorTrigger, threshold, absoluteThreshold float64) bool {
+	return p.pollIfEligible(trigger, DeviationThresholds{Rel: threshold, Abs: absoluteThreshold})
+}
+
+func (p *PollingDeviationChecker) ExportedSetCostEstimator(linkEthFeed contracts.FluxAggregator, config orm.ConfigReader) {
+	p.costEstimator = newSubmissionCostEstimator(linkEthFeed, config)
+}
+
+func (p *PollingDeviationChecker) ExportedResetDrumbeat(now time.Time) {
+	p.resetDrumbeat(now)
+}
+
+func (p *PollingDeviationChecker) ExportedRespondToIdleTimer(now time.Time) bool {
+	return p.respondToIdleTimer(now)
+}
+
+func (p *PollingDeviationChecker) ExportedRespondToDrumbeat(now time.Time) bool {
+	return p.respondToDrumbeat(now)
 }
 
 func (p *PollingDeviationChecker) ExportedSetStoredReportableRoundID(roundID *big.Int) {
@@ -45,6 +72,30 @@ func (p *PollingDeviationChecker) ExportedSufficientPayment(payment *big.Int) bo
 	return p.sufficientPayment(payment)
 }
 
+func (w *Watchdog) ExportedLoadLatestRound(now time.Time) {
+	w.loadLatestRound(now)
+}
+
+func (w *Watchdog) ExportedPoll() bool {
+	return w.poll()
+}
+
+func (w *Watchdog) ExportedCheckStaleness(now time.Time) bool {
+	return w.checkStaleness(now)
+}
+
+func (w *Watchdog) ExportedRespondToAnswerUpdatedLog(log *contracts.LogAnswerUpdated, now time.Time) {
+	w.respondToAnswerUpdatedLog(*log, now)
+}
+
+func (w *Watchdog) ExportedRespondToSubmissionReceivedLog(log *contracts.LogSubmissionReceived) {
+	w.respondToSubmissionReceivedLog(*log)
+}
+
+func (w *Watchdog) ExportedCloseRoundsBefore(roundID uint32) []common.Address {
+	return w.closeRoundsBefore(roundID)
+}
+
 func ExportedConsumeLogBroadcast(lb eth.LogBroadcast, callback func()) {
 	consumeLogBroadcast(lb, callback)
 }
@@ -113,5 +164,6 @@ func (fm *concreteFluxMonitor) CreateJob(t *testing.T,
 	checker, err := fm.checkerFactory.New(jobSpec.Initiators[0], nil, fm.runManager,
 		fm.store.ORM, models.MustMakeDuration(100*time.Second))
 	require.NoError(t, err, "could not create deviation checker")
-	return checker.(*PollingDeviationChecker).createJobRun(polledAnswer, nextRound)
+	_, err = checker.(*PollingDeviationChecker).createJobRun(polledAnswer, nextRound)
+	return err
 }
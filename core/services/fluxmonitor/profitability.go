@@ -0,0 +1,86 @@
+package fluxmonitor
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// ErrUnprofitable is returned when a submission is estimated to cost more gas
+// than the round pays.
+var ErrUnprofitable = errors.New("submission costs more than the round payment")
+
+// juelsPerLink is both the number of juels in a LINK and of wei in an ETH,
+// the precision of the LINK/ETH answer.
+var juelsPerLink = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// submissionCostEstimator estimates the cost in LINK of a submission, from the
+// gas it is expected to use at the current gas price, and the price of LINK
+// answered by a LINK/ETH feed.
+type submissionCostEstimator struct {
+	linkEthFeed contracts.FluxAggregator
+	config      orm.ConfigReader
+}
+
+func newSubmissionCostEstimator(linkEthFeed contracts.FluxAggregator, config orm.ConfigReader) *submissionCostEstimator {
+	return &submissionCostEstimator{linkEthFeed: linkEthFeed, config: config}
+}
+
+// Estimate returns the cost of a submission in juels.
+func (e *submissionCostEstimator) Estimate() (*assets.Link, error) {
+	weiPerLink, err := e.linkEthFeed.LatestAnswer()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the LINK/ETH feed")
+	} else if weiPerLink == nil || weiPerLink.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid LINK/ETH answer %v", weiPerLink)
+	}
+
+	cost := new(big.Int).SetUint64(e.config.FluxMonitorSubmissionGas())
+	cost.Mul(cost, e.config.EthGasPriceDefault())
+	cost.Mul(cost, juelsPerLink)
+	cost.Div(cost, weiPerLink)
+	return (*assets.Link)(cost), nil
+}
+
+// submissionIsMandatory returns whether the answer must be submitted whatever
+// it costs: the first round of the aggregator, and the heartbeats of the idle
+// timer and drumbeat. Other submissions can wait for a later round.
+func submissionIsMandatory(trigger models.FluxMonitorTrigger, reportableRoundID uint32) bool {
+	return trigger == models.FluxMonitorTriggerIdle ||
+		trigger == models.FluxMonitorTriggerDrumbeat ||
+		reportableRoundID <= 1
+}
+
+// checkProfitability returns ErrUnprofitable if the cost estimator is
+// configured and the submission considered for the round is not mandatory and
+// costs more than its payment. The estimated cost is recorded on the round. If
+// the cost cannot be estimated, the submission goes ahead.
+func (p *PollingDeviationChecker) checkProfitability(round *models.FluxMonitorRound, payment *big.Int) error {
+	if p.costEstimator == nil {
+		return nil
+	}
+	jobSpecID := p.initr.JobSpecID.String()
+
+	cost, err := p.costEstimator.Estimate()
+	if err != nil {
+		logger.Warnw(fmt.Sprintf("unable to estimate the cost of the submission, submitting anyway: %v", err),
+			"jobID", p.initr.JobSpecID, "round", round.RoundID)
+		return nil
+	}
+	round.SubmissionCost = cost
+	promSetDecimal(promFMSubmissionCost.WithLabelValues(jobSpecID), decimal.NewFromBigInt(cost.ToInt(), -18))
+
+	if submissionIsMandatory(round.Trigger, round.RoundID) || cost.ToInt().Cmp(payment) <= 0 {
+		return nil
+	}
+	promFMUnprofitableSubmissions.WithLabelValues(jobSpecID, string(round.Trigger)).Inc()
+	return errors.Wrapf(ErrUnprofitable, "estimated cost %v > payment %v", cost, (*assets.Link)(payment))
+}
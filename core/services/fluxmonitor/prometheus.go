@@ -25,6 +25,27 @@ var (
 		},
 		[]string{"url"},
 	)
+	promFMDroppedSources = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "flux_monitor_dropped_sources",
+			Help: "Number of times each endpoint was left out of the median, by reason",
+		},
+		[]string{"url", "reason"},
+	)
+	promFMSubmissionCost = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "flux_monitor_submission_cost",
+			Help: "Flux monitor's last estimated cost of a submission, in LINK",
+		},
+		[]string{"job_spec_id"},
+	)
+	promFMUnprofitableSubmissions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "flux_monitor_unprofitable_submissions",
+			Help: "Number of submissions skipped because they cost more than the round payment, by trigger",
+		},
+		[]string{"job_spec_id", "trigger"},
+	)
 	promFMSeenValue = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "flux_monitor_seen_value",
@@ -46,6 +67,41 @@ var (
 		},
 		[]string{"job_spec_id"},
 	)
+	promFMWatchdogAlerts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "flux_monitor_watchdog_alerts",
+			Help: "Number of alerts raised by flux monitor watchdogs, by alert",
+		},
+		[]string{"job_spec_id", "alert"},
+	)
+	promFMWatchdogAnswerAge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "flux_monitor_watchdog_answer_age_seconds",
+			Help: "Time since the answer of the aggregator followed by a flux monitor watchdog was last updated",
+		},
+		[]string{"job_spec_id"},
+	)
+	promFMWatchdogDeviation = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "flux_monitor_watchdog_deviation",
+			Help: "Deviation of the answer of the aggregator followed by a flux monitor watchdog from the answer of its feeds, in percent",
+		},
+		[]string{"job_spec_id"},
+	)
+	promFMWatchdogOracleSubmissions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "flux_monitor_watchdog_oracle_submissions",
+			Help: "Number of submissions of each oracle seen by a flux monitor watchdog",
+		},
+		[]string{"job_spec_id", "oracle"},
+	)
+	promFMWatchdogOracleMissedRounds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "flux_monitor_watchdog_oracle_missed_rounds",
+			Help: "Number of consecutive rounds each oracle did not submit to, seen by a flux monitor watchdog",
+		},
+		[]string{"job_spec_id", "oracle"},
+	)
 	promFMResponseTime = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "flux_monitor_request_duration_seconds",
@@ -0,0 +1,480 @@
+package fluxmonitor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/tevino/abool"
+)
+
+// WatchdogAlert is the kind of problem a Watchdog alerts on.
+type WatchdogAlert string
+
+const (
+	// WatchdogAlertStale is raised when the answer of the aggregator has not
+	// been updated for longer than the idleTimer duration of the initiator.
+	WatchdogAlertStale = WatchdogAlert("stale")
+	// WatchdogAlertDeviation is raised when the answer of the aggregator is
+	// outside the deviation thresholds of the initiator from the answer of its
+	// feeds.
+	WatchdogAlertDeviation = WatchdogAlert("deviation")
+	// WatchdogAlertMissedRounds is raised when an oracle of the aggregator has
+	// not submitted to WatchdogMissedRoundsThreshold rounds in a row.
+	WatchdogAlertMissedRounds = WatchdogAlert("missed_rounds")
+	// WatchdogAlertUnreadable is raised when the latest round of the
+	// aggregator cannot be read, such as when a WhitelistedAggregator has not
+	// whitelisted the node.
+	WatchdogAlertUnreadable = WatchdogAlert("unreadable")
+)
+
+// WatchdogAlertMessage is POSTed to FLUX_MONITOR_WATCHDOG_ALERT_URL when a
+// Watchdog raises an alert.
+type WatchdogAlertMessage struct {
+	JobID      *models.ID     `json:"jobId"`
+	Aggregator common.Address `json:"aggregator"`
+	Alert      WatchdogAlert  `json:"alert"`
+	Message    string         `json:"message"`
+}
+
+// WatchdogMissedRoundsThreshold is the number of consecutive rounds an oracle
+// may miss before a Watchdog alerts.
+const WatchdogMissedRoundsThreshold = 3
+
+// watchdogBacklogSize is the number of logs a Watchdog buffers, enough to
+// hold the submissions of every oracle of a round.
+const watchdogBacklogSize = 256
+
+// Watchdog is the DeviationChecker of watch-only flux monitor initiators. It
+// follows the rounds of an aggregator the node does not submit to, and alerts
+// when its answer goes stale, deviates from the answer of the feeds of the
+// initiator, or when oracles stop submitting. Alerts are logged, counted by
+// the flux_monitor_watchdog_alerts metric and POSTed to
+// FLUX_MONITOR_WATCHDOG_ALERT_URL when it is set.
+//
+// A Watchdog never creates job runs, so it never sends transactions.
+type Watchdog struct {
+	fluxAggregator contracts.FluxAggregator
+	fetcher        Fetcher
+	initr          models.Initiator
+	config         orm.ConfigReader
+
+	connected     *abool.AtomicBool
+	paused        *abool.AtomicBool
+	backlog       *utils.BoundedQueue
+	chProcessLogs chan struct{}
+	pollTicker    <-chan time.Time
+	staleTimer    <-chan time.Time
+
+	// The following are only accessed by the consume goroutine.
+	latestRoundID *big.Int
+	latestAnswer  *big.Int
+	updatedAt     time.Time
+	stale         bool
+	submissions   map[uint32]map[common.Address]bool
+	missedRounds  map[common.Address]int
+
+	readyForLogs func()
+	chStop       chan struct{}
+	waitOnStop   chan struct{}
+}
+
+// NewWatchdog returns a Watchdog following the aggregator of the watch-only
+// initiator.
+func NewWatchdog(
+	fluxAggregator contracts.FluxAggregator,
+	initr models.Initiator,
+	fetcher Fetcher,
+	config orm.ConfigReader,
+	readyForLogs func(),
+) *Watchdog {
+	return &Watchdog{
+		fluxAggregator: fluxAggregator,
+		fetcher:        fetcher,
+		initr:          initr,
+		config:         config,
+		connected:      abool.New(),
+		paused:         abool.NewBool(initr.Paused),
+		backlog:        utils.NewBoundedQueue(watchdogBacklogSize),
+		chProcessLogs:  make(chan struct{}, 1),
+		submissions:    make(map[uint32]map[common.Address]bool),
+		missedRounds:   make(map[common.Address]int),
+		readyForLogs:   readyForLogs,
+		chStop:         make(chan struct{}),
+		waitOnStop:     make(chan struct{}),
+	}
+}
+
+// Start begins following the aggregator in a single goroutine.
+func (w *Watchdog) Start() {
+	logger.Debugw("Starting watchdog for job",
+		"job", w.initr.JobSpecID.String(),
+		"initr", w.initr.ID)
+
+	go w.consume()
+}
+
+// Stop stops following the aggregator, cleaning up resources.
+func (w *Watchdog) Stop() {
+	close(w.chStop)
+	<-w.waitOnStop
+}
+
+// Pause silences the alerts of the watchdog, which keeps following the rounds
+// of the aggregator.
+func (w *Watchdog) Pause() {
+	logger.Infow("Pausing watchdog for job",
+		"job", w.initr.JobSpecID.String(),
+		"initr", w.initr.ID)
+	w.paused.Set()
+}
+
+// Resume lets a paused watchdog raise alerts again.
+func (w *Watchdog) Resume() {
+	logger.Infow("Resuming watchdog for job",
+		"job", w.initr.JobSpecID.String(),
+		"initr", w.initr.ID)
+	w.paused.UnSet()
+}
+
+func (w *Watchdog) OnConnect() {
+	logger.Debugw("Watchdog connected to Ethereum node",
+		"address", w.initr.Address.Hex(),
+	)
+	w.connected.Set()
+}
+
+func (w *Watchdog) OnDisconnect() {
+	logger.Debugw("Watchdog disconnected from Ethereum node",
+		"address", w.initr.Address.Hex(),
+	)
+	w.connected.UnSet()
+}
+
+func (w *Watchdog) JobID() *models.ID {
+	return w.initr.JobSpecID
+}
+
+func (w *Watchdog) HandleLog(lb eth.LogBroadcast, err error) {
+	rawLog := lb.Log()
+	if rawLog == nil || reflect.ValueOf(rawLog).IsNil() {
+		logger.Error("HandleLog: ignoring nil value")
+		return
+	}
+
+	w.backlog.Add(maybeLog{lb, err})
+	select {
+	case w.chProcessLogs <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watchdog) consume() {
+	defer close(w.waitOnStop)
+
+	connected, unsubscribeLogs := w.fluxAggregator.SubscribeToSubmissions(w)
+	defer unsubscribeLogs()
+
+	if connected {
+		w.connected.Set()
+	} else {
+		w.connected.UnSet()
+	}
+
+	w.readyForLogs()
+	w.loadLatestRound(time.Now())
+
+	if !w.initr.PollTimer.Disabled {
+		w.poll()
+
+		ticker := time.NewTicker(w.initr.PollTimer.Period.Duration())
+		defer ticker.Stop()
+		w.pollTicker = ticker.C
+	}
+	w.resetStaleTimer(time.Now())
+
+	for {
+		select {
+		case <-w.chStop:
+			return
+
+		case <-w.chProcessLogs:
+			w.processLogs()
+
+		case <-w.pollTicker:
+			w.poll()
+
+		case <-w.staleTimer:
+			w.checkStaleness(time.Now())
+		}
+	}
+}
+
+// loadLatestRound reads the latest answer of the aggregator. If it cannot be
+// read, an alert is raised, the answer is considered to have been updated at
+// startup, and is learnt from the next AnswerUpdated log.
+func (w *Watchdog) loadLatestRound(now time.Time) {
+	w.updatedAt = now
+
+	roundData, err := w.fluxAggregator.LatestRoundData()
+	if err != nil {
+		msg := fmt.Sprintf("unable to read the latest round of the aggregator: %v", err)
+		logger.Errorw(msg, w.loggerFields()...)
+		w.alert(WatchdogAlertUnreadable, msg)
+		return
+	}
+	w.latestRoundID = roundData.RoundID
+	w.latestAnswer = roundData.Answer
+	if roundData.UpdatedAt != nil && roundData.UpdatedAt.Sign() > 0 && roundData.UpdatedAt.IsInt64() {
+		w.updatedAt = time.Unix(roundData.UpdatedAt.Int64(), 0)
+	}
+}
+
+func (w *Watchdog) processLogs() {
+	for !w.backlog.Empty() {
+		maybeLog := w.backlog.Take().(maybeLog)
+
+		if maybeLog.Err != nil {
+			logger.Errorf("error received from log broadcaster: %v", maybeLog.Err)
+			continue
+		}
+
+		switch log := maybeLog.LogBroadcast.Log().(type) {
+		case *contracts.LogNewRound:
+			consumeLogBroadcast(maybeLog.LogBroadcast, func() { w.respondToNewRoundLog(*log) })
+
+		case *contracts.LogAnswerUpdated:
+			consumeLogBroadcast(maybeLog.LogBroadcast, func() { w.respondToAnswerUpdatedLog(*log, time.Now()) })
+
+		case *contracts.LogSubmissionReceived:
+			consumeLogBroadcast(maybeLog.LogBroadcast, func() { w.respondToSubmissionReceivedLog(*log) })
+
+		default:
+			logger.Errorf("unknown log %v of type %T", log, log)
+		}
+	}
+}
+
+// respondToNewRoundLog closes the participation of oracles to the rounds
+// before the new one.
+//
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (w *Watchdog) respondToNewRoundLog(log contracts.LogNewRound) {
+	promSetBigInt(promFMSeenRound.WithLabelValues(w.initr.JobSpecID.String()), log.RoundId)
+	if log.RoundId != nil && log.RoundId.IsUint64() {
+		w.closeRoundsBefore(uint32(log.RoundId.Uint64()))
+	}
+}
+
+// respondToAnswerUpdatedLog records the new answer of the aggregator.
+//
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (w *Watchdog) respondToAnswerUpdatedLog(log contracts.LogAnswerUpdated, now time.Time) {
+	if w.latestRoundID != nil && log.RoundId != nil && log.RoundId.Cmp(w.latestRoundID) < 0 {
+		logger.Debugw("Received stale AnswerUpdated log", w.loggerFields("round", log.RoundId)...)
+		return
+	}
+	w.latestRoundID = log.RoundId
+	w.latestAnswer = log.Current
+	w.updatedAt = now
+	if log.Timestamp != nil && log.Timestamp.Sign() > 0 && log.Timestamp.IsInt64() {
+		w.updatedAt = time.Unix(log.Timestamp.Int64(), 0)
+	}
+	if w.stale {
+		logger.Infow("Aggregator answer updated, no longer stale", w.loggerFields("round", log.RoundId)...)
+	}
+	w.stale = false
+	w.resetStaleTimer(now)
+}
+
+// respondToSubmissionReceivedLog records the participation of the oracle to
+// the round.
+//
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (w *Watchdog) respondToSubmissionReceivedLog(log contracts.LogSubmissionReceived) {
+	if w.submissions[log.Round] == nil {
+		w.submissions[log.Round] = make(map[common.Address]bool)
+	}
+	w.submissions[log.Round][log.Oracle] = true
+	promFMWatchdogOracleSubmissions.WithLabelValues(w.initr.JobSpecID.String(), log.Oracle.Hex()).Inc()
+}
+
+// closeRoundsBefore counts, for each oracle of the aggregator, the rounds
+// before roundID it did not submit to, and alerts on those which missed
+// WatchdogMissedRoundsThreshold rounds in a row. It returns the oracles
+// alerted on.
+func (w *Watchdog) closeRoundsBefore(roundID uint32) []common.Address {
+	var rounds []uint32
+	for round := range w.submissions {
+		if round < roundID {
+			rounds = append(rounds, round)
+		}
+	}
+	if len(rounds) == 0 {
+		return nil
+	}
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i] < rounds[j] })
+
+	oracles, err := w.fluxAggregator.Oracles()
+	if err != nil {
+		logger.Warnw(fmt.Sprintf("unable to read the oracles of the aggregator: %v", err), w.loggerFields()...)
+		return nil
+	}
+
+	jobSpecID := w.initr.JobSpecID.String()
+	var alerted []common.Address
+	for _, round := range rounds {
+		for _, oracle := range oracles {
+			if w.submissions[round][oracle] {
+				w.missedRounds[oracle] = 0
+			} else {
+				w.missedRounds[oracle]++
+			}
+		}
+		delete(w.submissions, round)
+	}
+	for _, oracle := range oracles {
+		missed := w.missedRounds[oracle]
+		promFMWatchdogOracleMissedRounds.WithLabelValues(jobSpecID, oracle.Hex()).Set(float64(missed))
+		if missed >= WatchdogMissedRoundsThreshold {
+			if w.alert(WatchdogAlertMissedRounds,
+				fmt.Sprintf("oracle %s missed %d rounds in a row", oracle.Hex(), missed),
+				"oracle", oracle.Hex(), "missedRounds", missed) {
+				alerted = append(alerted, oracle)
+			}
+		}
+	}
+	return alerted
+}
+
+// poll fetches the answer of the feeds, and alerts if the answer of the
+// aggregator deviates from it by more than the thresholds of the initiator.
+// It returns whether it alerted.
+//
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (w *Watchdog) poll() bool {
+	promFMWatchdogAnswerAge.WithLabelValues(w.initr.JobSpecID.String()).Set(time.Since(w.updatedAt).Seconds())
+
+	if w.latestAnswer == nil {
+		logger.Debugw("No aggregator answer to compare with the feeds yet", w.loggerFields()...)
+		return false
+	}
+
+	polledAnswer, err := w.fetcher.Fetch()
+	if err != nil {
+		logger.Errorw(fmt.Sprintf("unable to fetch the answer of the feeds: %v", err), w.loggerFields()...)
+		return false
+	}
+
+	jobSpecID := w.initr.JobSpecID.String()
+	promSetDecimal(promFMSeenValue.WithLabelValues(jobSpecID), polledAnswer)
+
+	latestAnswer := decimal.NewFromBigInt(w.latestAnswer, -w.initr.Precision)
+	if deviation := relativeDeviation(polledAnswer, latestAnswer); deviation.Valid {
+		promSetDecimal(promFMWatchdogDeviation.WithLabelValues(jobSpecID), deviation.Decimal)
+	}
+
+	thresholds := DeviationThresholds{Rel: float64(w.initr.Threshold), Abs: float64(w.initr.AbsoluteThreshold)}
+	if !OutsideDeviation(polledAnswer, latestAnswer, thresholds) {
+		return false
+	}
+	return w.alert(WatchdogAlertDeviation,
+		fmt.Sprintf("aggregator answer %v deviates from the feeds' answer %v", latestAnswer, polledAnswer),
+		"aggregatorAnswer", latestAnswer, "polledAnswer", polledAnswer, "round", w.latestRoundID)
+}
+
+// checkStaleness alerts once if the answer of the aggregator has not been
+// updated for longer than the idleTimer duration of the initiator. It returns
+// whether it alerted.
+//
+// Only invoked by the CSP consumer on the single goroutine for thread safety.
+func (w *Watchdog) checkStaleness(now time.Time) bool {
+	if w.initr.IdleTimer.Disabled || w.stale {
+		return false
+	}
+
+	age := now.Sub(w.updatedAt)
+	promFMWatchdogAnswerAge.WithLabelValues(w.initr.JobSpecID.String()).Set(age.Seconds())
+	if age < w.initr.IdleTimer.Duration.Duration() {
+		w.resetStaleTimer(now)
+		return false
+	}
+
+	w.stale = true
+	return w.alert(WatchdogAlertStale,
+		fmt.Sprintf("aggregator answer not updated for %s", age.Round(time.Second)),
+		"updatedAt", w.updatedAt, "round", w.latestRoundID)
+}
+
+func (w *Watchdog) resetStaleTimer(now time.Time) {
+	if w.initr.IdleTimer.Disabled {
+		return
+	}
+	untilStale := w.updatedAt.Add(w.initr.IdleTimer.Duration.Duration()).Sub(now)
+	if untilStale < 0 {
+		untilStale = 0
+	}
+	w.staleTimer = time.After(untilStale)
+}
+
+// alert logs, counts and sends the alert, unless the watchdog is paused. It
+// returns whether the alert was raised.
+func (w *Watchdog) alert(kind WatchdogAlert, msg string, fields ...interface{}) bool {
+	if w.paused.IsSet() {
+		logger.Debugw(fmt.Sprintf("Watchdog paused, not alerting: %s", msg), w.loggerFields(fields...)...)
+		return false
+	}
+	logger.Warnw(fmt.Sprintf("Watchdog alert: %s", msg), w.loggerFields(append(fields, "alert", kind)...)...)
+	promFMWatchdogAlerts.WithLabelValues(w.initr.JobSpecID.String(), string(kind)).Inc()
+
+	if alertURL := w.config.FluxMonitorWatchdogAlertURL(); alertURL != nil {
+		go w.sendAlert(alertURL.String(), WatchdogAlertMessage{
+			JobID:      w.initr.JobSpecID,
+			Aggregator: w.initr.Address,
+			Alert:      kind,
+			Message:    msg,
+		})
+	}
+	return true
+}
+
+// sendAlert POSTs the alert in its own goroutine, so that a slow alert
+// endpoint does not hold up the processing of logs.
+func (w *Watchdog) sendAlert(alertURL string, alert WatchdogAlertMessage) {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		logger.Error("Watchdog: error encoding alert: ", err)
+		return
+	}
+	client := &http.Client{Timeout: w.config.DefaultHTTPTimeout().Duration()}
+	resp, err := client.Post(alertURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logger.Error("Watchdog: error sending alert: ", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		logger.Errorw("Watchdog: alert was rejected", "url", alertURL, "status", resp.StatusCode)
+	}
+}
+
+func (w *Watchdog) loggerFields(added ...interface{}) []interface{} {
+	return append(added, []interface{}{
+		"jobID", w.initr.JobSpecID,
+		"aggregator", w.initr.Address.Hex(),
+	}...)
+}
@@ -0,0 +1,242 @@
+package fluxmonitor_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	ethsvc "github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newWatchOnlyInitiator() models.Initiator {
+	initr := cltest.NewJobWithFluxMonitorInitiator().Initiators[0]
+	initr.WatchOnly = true
+	return initr
+}
+
+func TestWatchdog_AlertsOnDeviation(t *testing.T) {
+	initr := newWatchOnlyInitiator() // threshold 0.5%, precision 2
+
+	fluxAggregator := new(mocks.FluxAggregator)
+	fluxAggregator.On("LatestRoundData").Return(contracts.FluxAggregatorRoundData{
+		RoundID: big.NewInt(3),
+		Answer:  big.NewInt(10000),
+	}, nil)
+	fetcher := new(mocks.Fetcher)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	watchdog := fluxmonitor.NewWatchdog(fluxAggregator, initr, fetcher, config, func() {})
+	watchdog.ExportedLoadLatestRound(time.Now())
+
+	fetcher.On("Fetch").Return(decimal.NewFromFloat(100.2), nil).Once()
+	assert.False(t, watchdog.ExportedPoll(), "within the thresholds")
+
+	fetcher.On("Fetch").Return(decimal.NewFromInt(110), nil).Once()
+	assert.True(t, watchdog.ExportedPoll(), "outside the thresholds")
+
+	fetcher.On("Fetch").Return(decimal.Decimal{}, errors.New("feeds down")).Once()
+	assert.False(t, watchdog.ExportedPoll())
+
+	watchdog.Pause()
+	fetcher.On("Fetch").Return(decimal.NewFromInt(110), nil).Once()
+	assert.False(t, watchdog.ExportedPoll(), "silenced while paused")
+
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+}
+
+func TestWatchdog_NoAnswerToCompareWith(t *testing.T) {
+	fluxAggregator := new(mocks.FluxAggregator)
+	fluxAggregator.On("LatestRoundData").Return(contracts.FluxAggregatorRoundData{}, errors.New("not whitelisted"))
+	fetcher := new(mocks.Fetcher)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	watchdog := fluxmonitor.NewWatchdog(fluxAggregator, newWatchOnlyInitiator(), fetcher, config, func() {})
+	watchdog.ExportedLoadLatestRound(time.Now())
+
+	assert.False(t, watchdog.ExportedPoll())
+	fetcher.AssertNotCalled(t, "Fetch")
+
+	// The answer is learnt from the logs instead
+	watchdog.ExportedRespondToAnswerUpdatedLog(&contracts.LogAnswerUpdated{
+		Current: big.NewInt(10000),
+		RoundId: big.NewInt(4),
+	}, time.Now())
+	fetcher.On("Fetch").Return(decimal.NewFromInt(120), nil).Once()
+	assert.True(t, watchdog.ExportedPoll())
+	fetcher.AssertExpectations(t)
+}
+
+func TestWatchdog_SendsAlerts(t *testing.T) {
+	var mutex sync.Mutex
+	var alerts []fluxmonitor.WatchdogAlertMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert fluxmonitor.WatchdogAlertMessage
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		mutex.Lock()
+		alerts = append(alerts, alert)
+		mutex.Unlock()
+	}))
+	defer server.Close()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("FLUX_MONITOR_WATCHDOG_ALERT_URL", server.URL)
+
+	initr := newWatchOnlyInitiator()
+	fluxAggregator := new(mocks.FluxAggregator)
+	fluxAggregator.On("LatestRoundData").Return(contracts.FluxAggregatorRoundData{}, errors.New("not whitelisted"))
+
+	watchdog := fluxmonitor.NewWatchdog(fluxAggregator, initr, new(mocks.Fetcher), config, func() {})
+	watchdog.ExportedLoadLatestRound(time.Now())
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(alerts)
+	}).Should(gomega.Equal(1))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, initr.JobSpecID, alerts[0].JobID)
+	assert.Equal(t, initr.Address, alerts[0].Aggregator)
+	assert.Equal(t, fluxmonitor.WatchdogAlertUnreadable, alerts[0].Alert)
+	assert.Contains(t, alerts[0].Message, "not whitelisted")
+}
+
+func TestWatchdog_AlertsOnStaleAnswer(t *testing.T) {
+	initr := newWatchOnlyInitiator() // idleTimer 1 minute
+	now := time.Now()
+
+	fluxAggregator := new(mocks.FluxAggregator)
+	fluxAggregator.On("LatestRoundData").Return(contracts.FluxAggregatorRoundData{
+		RoundID:   big.NewInt(3),
+		Answer:    big.NewInt(10000),
+		UpdatedAt: big.NewInt(now.Add(-30 * time.Second).Unix()),
+	}, nil)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	watchdog := fluxmonitor.NewWatchdog(fluxAggregator, initr, new(mocks.Fetcher), config, func() {})
+	watchdog.ExportedLoadLatestRound(now)
+
+	assert.False(t, watchdog.ExportedCheckStaleness(now))
+	assert.True(t, watchdog.ExportedCheckStaleness(now.Add(time.Minute)))
+	assert.False(t, watchdog.ExportedCheckStaleness(now.Add(2*time.Minute)), "alerts once")
+
+	// Stale AnswerUpdated logs are ignored
+	watchdog.ExportedRespondToAnswerUpdatedLog(&contracts.LogAnswerUpdated{
+		Current:   big.NewInt(10000),
+		RoundId:   big.NewInt(2),
+		Timestamp: big.NewInt(now.Add(2 * time.Minute).Unix()),
+	}, now.Add(2*time.Minute))
+	assert.False(t, watchdog.ExportedCheckStaleness(now.Add(4*time.Minute)))
+
+	watchdog.ExportedRespondToAnswerUpdatedLog(&contracts.LogAnswerUpdated{
+		Current:   big.NewInt(10100),
+		RoundId:   big.NewInt(4),
+		Timestamp: big.NewInt(now.Add(2 * time.Minute).Unix()),
+	}, now.Add(2*time.Minute))
+	assert.False(t, watchdog.ExportedCheckStaleness(now.Add(150*time.Second)))
+	assert.True(t, watchdog.ExportedCheckStaleness(now.Add(4*time.Minute)), "stale again")
+}
+
+func TestWatchdog_AlertsOnMissedRounds(t *testing.T) {
+	oracleA := cltest.NewAddress()
+	oracleB := cltest.NewAddress()
+
+	fluxAggregator := new(mocks.FluxAggregator)
+	fluxAggregator.On("Oracles").Return([]common.Address{oracleA, oracleB}, nil)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	watchdog := fluxmonitor.NewWatchdog(fluxAggregator, newWatchOnlyInitiator(), new(mocks.Fetcher), config, func() {})
+
+	submit := func(round uint32, oracles ...common.Address) {
+		for _, oracle := range oracles {
+			watchdog.ExportedRespondToSubmissionReceivedLog(&contracts.LogSubmissionReceived{
+				Submission: big.NewInt(100),
+				Round:      round,
+				Oracle:     oracle,
+			})
+		}
+	}
+
+	submit(1, oracleA)
+	submit(2, oracleA)
+	assert.Empty(t, watchdog.ExportedCloseRoundsBefore(3))
+
+	submit(3, oracleA)
+	assert.Empty(t, watchdog.ExportedCloseRoundsBefore(3), "round 3 is still open")
+	assert.Equal(t, []common.Address{oracleB}, watchdog.ExportedCloseRoundsBefore(4))
+
+	submit(4, oracleA, oracleB)
+	assert.Empty(t, watchdog.ExportedCloseRoundsBefore(5))
+}
+
+func TestWatchdog_FollowsLogs(t *testing.T) {
+	initr := newWatchOnlyInitiator()
+	initr.PollTimer.Disabled = true
+	initr.IdleTimer.Disabled = true
+	oracle := cltest.NewAddress()
+
+	fluxAggregator := new(mocks.FluxAggregator)
+	fluxAggregator.On("SubscribeToSubmissions", mock.Anything).Return(true, ethsvc.UnsubscribeFunc(func() {}))
+	fluxAggregator.On("LatestRoundData").Return(contracts.FluxAggregatorRoundData{RoundID: big.NewInt(1)}, nil)
+	fluxAggregator.On("Oracles").Return([]common.Address{oracle}, nil).Once()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	watchdog := fluxmonitor.NewWatchdog(fluxAggregator, initr, new(mocks.Fetcher), config, func() {})
+	watchdog.Start()
+	defer watchdog.Stop()
+
+	logs := []interface{}{
+		&contracts.LogSubmissionReceived{Submission: big.NewInt(100), Round: 2, Oracle: oracle},
+		&contracts.LogAnswerUpdated{Current: big.NewInt(100), RoundId: big.NewInt(2), Timestamp: big.NewInt(time.Now().Unix())},
+		&contracts.LogNewRound{RoundId: big.NewInt(3), StartedBy: oracle, StartedAt: big.NewInt(time.Now().Unix())},
+	}
+	processed := make(chan struct{})
+	var logBroadcasts []*mocks.LogBroadcast
+	for i, log := range logs {
+		logBroadcast := new(mocks.LogBroadcast)
+		logBroadcast.On("Log").Return(log)
+		logBroadcast.On("WasAlreadyConsumed").Return(false, nil)
+		markConsumed := logBroadcast.On("MarkConsumed").Return(nil)
+		if i == len(logs)-1 {
+			markConsumed.Run(func(mock.Arguments) { close(processed) })
+		}
+		logBroadcasts = append(logBroadcasts, logBroadcast)
+	}
+	for _, logBroadcast := range logBroadcasts {
+		watchdog.HandleLog(logBroadcast, nil)
+	}
+
+	cltest.CallbackOrTimeout(t, "logs processed", func() {
+		<-processed
+	})
+	for _, logBroadcast := range logBroadcasts {
+		logBroadcast.AssertExpectations(t)
+	}
+	fluxAggregator.AssertExpectations(t)
+}
@@ -3,144 +3,137 @@ package services
 import (
 	"fmt"
 	"math/big"
-	"sort"
 
+	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/eth"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
-	"github.com/smartcontractkit/chainlink/core/utils"
-
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
-var (
-	promGasUpdaterAllPercentiles = promauto.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "gas_updater_all_gas_percetiles",
-		Help: "Gas price at given percentile",
-	},
-		[]string{"percentile"},
-	)
-
-	promGasUpdaterSetGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "gas_updater_set_gas_price",
-		Help: "Gas updater set gas price (in Wei)",
-	},
-		[]string{"percentile", "block_num"},
-	)
-)
-
-// GasUpdater listens for new heads and updates the base gas price dynamically
-// based on the configured percentile of gas prices in that block
+// GasUpdater listens for new heads and updates the default gas price of its
+// chain with the price picked by the chain's configured GasEstimator
 type GasUpdater interface {
 	store.HeadTrackable
 	RollingBlockHistory() []eth.Block
 }
 
 type gasUpdater struct {
-	store                   *store.Store
-	rollingBlockHistory     []eth.Block
-	rollingBlockHistorySize int
-	// HACK: blockDelay is the number of blocks that the gas updater trails behind head.
-	// E.g. if this is set to 3, and we receive block 10, gas updater will
-	// fetch block 7.
-	// This is necessary because geth/parity send heads as soon as they get
-	// them and often the actual block is not available until later. Fetching
-	// it too early results in an empty block.
-	blockDelay int64
-	percentile int
+	store   *store.Store
+	chainID *big.Int
 }
 
-// NewGasUpdater returns a new gas updater.
+// NewGasUpdater returns a new gas updater for the default chain.
 func NewGasUpdater(store *store.Store) GasUpdater {
-	gu := &gasUpdater{
-		store:                   store,
-		rollingBlockHistory:     make([]eth.Block, 0),
-		rollingBlockHistorySize: int(store.Config.GasUpdaterBlockHistorySize()),
-		blockDelay:              int64(store.Config.GasUpdaterBlockDelay()),
-		percentile:              int(store.Config.GasUpdaterTransactionPercentile()),
+	return NewChainGasUpdater(store, nil)
+}
+
+// NewChainGasUpdater returns a new gas updater setting the default gas price
+// of the given chain.
+func NewChainGasUpdater(store *store.Store, chainID *big.Int) GasUpdater {
+	return &gasUpdater{
+		store:   store,
+		chainID: chainID,
 	}
-	return gu
+}
+
+func (gu *gasUpdater) chain() (store.Chain, error) {
+	return resolveChain(gu.store, gu.chainID)
 }
 
 func (gu *gasUpdater) Connect(bn *models.Head) error {
-	if gu.store.Config.GasUpdaterEnabled() {
-		logger.Debugw("GasUpdater: dynamic gas updates are enabled", "ethGasPriceDefault", gu.store.Config.EthGasPriceDefault())
+	chain, err := gu.chain()
+	if err != nil {
+		return err
+	}
+	estimator := store.GasEstimatorName(chain.Config)
+	if estimator != store.GasEstimatorFixed {
+		logger.Debugw("GasUpdater: dynamic gas updates are enabled", "ethGasPriceDefault", chain.Config.EthGasPriceDefault(), "estimator", estimator, "chainID", chain.ID)
 	} else {
-		logger.Debugw("GasUpdater: dynamic gas updating is disabled", "ethGasPriceDefault", gu.store.Config.EthGasPriceDefault())
+		logger.Debugw("GasUpdater: dynamic gas updating is disabled", "ethGasPriceDefault", chain.Config.EthGasPriceDefault(), "chainID", chain.ID)
 	}
-	return nil
+	return gu.createJobGasEstimators(chain)
+}
+
+// createJobGasEstimators creates the gas estimators named by the EthTx tasks
+// of the jobs on the chain, so that they are sent every new head from now on
+// rather than from their first use.
+func (gu *gasUpdater) createJobGasEstimators(chain store.Chain) error {
+	return gu.store.Jobs(func(job *models.JobSpec) bool {
+		if job.Archived() {
+			return true
+		}
+		for _, task := range job.Tasks {
+			adapter, err := adapters.For(task, gu.store.Config, gu.store.ORM)
+			if err != nil {
+				continue
+			}
+			ethTx, ok := adapter.BaseAdapter.(*adapters.EthTx)
+			if !ok || ethTx.GasEstimator == "" {
+				continue
+			}
+			taskChain, err := gu.store.Chain(ethTx.ChainID.ToInt())
+			if err != nil || taskChain.ID.Cmp(chain.ID) != 0 {
+				continue
+			}
+			if _, err := gu.store.GasEstimator(chain.ID, ethTx.GasEstimator); err != nil {
+				logger.Warnw("GasUpdater: unable to create the gas estimator of a job", "job", job.ID, "estimator", ethTx.GasEstimator, "error", err)
+			}
+		}
+		return true
+	})
 }
 
 func (gu *gasUpdater) Disconnect() {
 }
 
-// OnNewHead recalculates and sets global gas price on every head
+// OnNewHead recalculates and sets the chain's default gas price on every head.
+// Estimates above ETH_MAX_GAS_PRICE_WEI are clamped to it by the estimator,
+// so the default is set to the maximum rather than left at its last value.
 func (gu *gasUpdater) OnNewHead(head *models.Head) {
-	// Bail out as early as possible if the gas updater is disabled so we avoid
-	// any potential undesired side effects. Note that in a future iteration
-	// the GasUpdaterEnabled setting could be modifiable at runtime
-	if !gu.store.Config.GasUpdaterEnabled() {
-		return
-	}
-	blockToFetch := head.Number - gu.blockDelay
-	if blockToFetch < 0 {
-		logger.Warnf("GasUpdater: skipping gas calculation, current block height %v is lower than GAS_UPDATER_BLOCK_DELAY of %v", head.Number, gu.blockDelay)
-		return
-	}
-	blockNumber := utils.Uint64ToHex(uint64(blockToFetch))
-	block, err := gu.store.TxManager.GetBlockByNumber(blockNumber)
+	// Bail out as early as possible if the gas price is fixed so we avoid
+	// any potential undesired side effects.
+	chain, err := gu.chain()
 	if err != nil {
-		logger.Error(err, fmt.Sprintf("GasUpdater: error retrieving block %v", blockToFetch))
+		logger.Error("GasUpdater: ", err)
 		return
 	}
-	logger.Debugw("GasUpdater: got block", "blockNumber", blockToFetch)
-	if len(block.Transactions) > 0 {
-		gu.rollingBlockHistory = append(gu.rollingBlockHistory, block)
-		if len(gu.rollingBlockHistory) > gu.rollingBlockHistorySize {
-			gu.rollingBlockHistory = gu.rollingBlockHistory[1:]
-			percentileGasPrice := gu.percentileGasPrice()
-			err := gu.setPercentileGasPrice(percentileGasPrice)
-			if err != nil {
-				logger.Error("GasUpdater error setting gas price: ", err)
-				return
-			}
-			promGasUpdaterSetGasPrice.WithLabelValues(fmt.Sprintf("%v%%", gu.percentile), string(blockToFetch)).Set(float64(percentileGasPrice))
-		} else {
-			logger.Debugw("GasUpdater: waiting for blocks", "inHistory", len(gu.rollingBlockHistory), "required", gu.rollingBlockHistorySize)
+	var estimator store.GasEstimator
+	if store.GasEstimatorName(chain.Config) != store.GasEstimatorFixed {
+		estimator, err = gu.store.GasEstimator(chain.ID, "")
+		if err != nil {
+			logger.Error("GasUpdater: ", err)
 		}
-	} else {
-		logger.Debugw("GasUpdater: skipping empty block", "blockNumber", blockToFetch)
 	}
-}
 
-func (gu *gasUpdater) percentileGasPrice() int64 {
-	gasPrices := make([]int64, 0)
-	for _, block := range gu.rollingBlockHistory {
-		for _, tx := range block.Transactions {
-			gasPrices = append(gasPrices, int64(tx.GasPrice))
-		}
+	// The estimators selected by jobs learn from the same heads, whether or
+	// not one of them sets the chain's default gas price
+	for _, e := range gu.store.GasEstimators(chain.ID) {
+		e.OnNewHead(head)
 	}
-	sort.Slice(gasPrices, func(i, j int) bool { return gasPrices[i] < gasPrices[j] })
-	idx := ((len(gasPrices) - 1) * gu.percentile) / 100
-	for i := 0; i <= 100; i += 5 {
-		jdx := ((len(gasPrices) - 1) * i) / 100
-		promGasUpdaterAllPercentiles.WithLabelValues(fmt.Sprintf("%v%%", i)).Set(float64(gasPrices[jdx]))
+	if estimator == nil {
+		return
 	}
-	return gasPrices[idx]
-}
 
-func (gu *gasUpdater) setPercentileGasPrice(gasPrice int64) error {
-	gasPriceGwei := fmt.Sprintf("%.2f", float64(gasPrice)/1000000000)
-	bigGasPrice := big.NewInt(gasPrice)
-	if bigGasPrice.Cmp(gu.store.Config.EthMaxGasPriceWei()) > 0 {
-		return fmt.Errorf("cannot set gas price %s because it exceeds EthMaxGasPriceWei %s", bigGasPrice.String(), gu.store.Config.EthMaxGasPriceWei().String())
+	gasPrice, err := estimator.GasPrice()
+	if err != nil {
+		logger.Debugw("GasUpdater: no gas price estimate", "estimator", estimator.Name(), "error", err)
+		return
+	}
+
+	gasPriceGwei := fmt.Sprintf("%.2f", float64(gasPrice.Int64())/1000000000)
+	logger.Debugw("GasUpdater: setting new default gas price", "gasPriceWei", gasPrice, "gasPriceGWei", gasPriceGwei, "estimator", estimator.Name(), "chainID", chain.ID)
+	if err := chain.Config.SetEthGasPriceDefault(gasPrice); err != nil {
+		logger.Error("GasUpdater error setting gas price: ", err)
 	}
-	logger.Debugw("GasUpdater: setting new default gas price", "gasPriceWei", gasPrice, "gasPriceGWei", gasPriceGwei)
-	return gu.store.Config.SetEthGasPriceDefault(bigGasPrice)
 }
 
+// RollingBlockHistory returns the blocks the chain's block history estimator
+// calculates the gas price from.
 func (gu *gasUpdater) RollingBlockHistory() []eth.Block {
-	return gu.rollingBlockHistory
+	estimator, err := gu.store.GasEstimator(gu.chainID, store.GasEstimatorBlockHistory)
+	if err != nil {
+		return nil
+	}
+	return estimator.(*store.BlockHistoryEstimator).RollingBlockHistory()
 }
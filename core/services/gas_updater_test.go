@@ -9,7 +9,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGasUpdater_OnNewHead_whenDisabledDoesNothing(t *testing.T) {
@@ -145,7 +147,7 @@ func TestGasUpdater_OnNewHead_SetsGlobalGasPriceWhenHistoryFull(t *testing.T) {
 	assert.Equal(t, big.NewInt(100), config.EthGasPriceDefault())
 }
 
-func TestGasUpdater_OnNewHead_WillNotSetGasHigherThanEthMaxGasPriceWei(t *testing.T) {
+func TestGasUpdater_OnNewHead_ClampsGasPriceToEthMaxGasPriceWei(t *testing.T) {
 	config, _ := cltest.NewConfig(t)
 	config.Set("GAS_UPDATER_ENABLED", "true")
 	config.Set("GAS_UPDATER_BLOCK_DELAY", "0")
@@ -169,5 +171,24 @@ func TestGasUpdater_OnNewHead_WillNotSetGasHigherThanEthMaxGasPriceWei(t *testin
 	head = cltest.Head(1)
 	gu.OnNewHead(head)
 
-	assert.Equal(t, big.NewInt(42), config.EthGasPriceDefault())
+	assert.Equal(t, big.NewInt(100), config.EthGasPriceDefault())
+}
+
+func TestGasUpdater_OnNewHead_SendsHeadsToJobGasEstimators(t *testing.T) {
+	config, _ := cltest.NewConfig(t)
+	config.Set("GAS_UPDATER_ENABLED", "false")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+	txm := new(mocks.TxManager)
+	store.TxManager = txm
+	gu := services.NewGasUpdater(store)
+
+	_, err := store.GasEstimator(nil, strpkg.GasEstimatorBlockHistory)
+	require.NoError(t, err)
+
+	txm.On("GetBlockByNumber", "0x0").Return(cltest.BlockWithTransactions(42), nil)
+	gu.OnNewHead(cltest.Head(3))
+
+	assert.Len(t, gu.RollingBlockHistory(), 1)
+	txm.AssertExpectations(t)
 }
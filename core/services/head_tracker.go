@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -23,12 +25,22 @@ var (
 		Name: "head_tracker_heads_received",
 		Help: "The total number of heads seen",
 	})
+	numberReorgs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "head_tracker_reorgs",
+		Help: "The total number of chain reorganisations seen",
+	})
+	reorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of heads replaced by each chain reorganisation",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 8),
+	})
 )
 
 // HeadTracker holds and stores the latest block number experienced by this particular node
 // in a thread safe manner. Reconstitutes the last block number from the data
 // store on reboot.
 type HeadTracker struct {
+	chainID               *big.Int
 	callbacks             []strpkg.HeadTrackable
 	headers               chan eth.BlockHeader
 	headSubscription      eth.Subscription
@@ -47,6 +59,12 @@ type HeadTracker struct {
 // Can be passed in an optional sleeper object that will dictate how often
 // it tries to reconnect.
 func NewHeadTracker(store *strpkg.Store, callbacks []strpkg.HeadTrackable, sleepers ...utils.Sleeper) *HeadTracker {
+	return NewChainHeadTracker(store, nil, callbacks, sleepers...)
+}
+
+// NewChainHeadTracker instantiates a new HeadTracker following the heads of
+// the given chain, or of the default chain if chainID is nil.
+func NewChainHeadTracker(store *strpkg.Store, chainID *big.Int, callbacks []strpkg.HeadTrackable, sleepers ...utils.Sleeper) *HeadTracker {
 	var sleeper utils.Sleeper
 	if len(sleepers) > 0 {
 		sleeper = sleepers[0]
@@ -54,12 +72,17 @@ func NewHeadTracker(store *strpkg.Store, callbacks []strpkg.HeadTrackable, sleep
 		sleeper = utils.NewBackoffSleeper()
 	}
 	return &HeadTracker{
+		chainID:   chainID,
 		store:     store,
 		callbacks: callbacks,
 		sleeper:   sleeper,
 	}
 }
 
+func (ht *HeadTracker) chain() (strpkg.Chain, error) {
+	return resolveChain(ht.store, ht.chainID)
+}
+
 // Start retrieves the last persisted block number from the HeadTracker,
 // subscribes to new heads, and if successful fires Connect on the
 // HeadTrackable argument.
@@ -102,7 +125,9 @@ func (ht *HeadTracker) Stop() error {
 		ht.connected = false
 		ht.disconnect()
 	}
-	logger.Info(fmt.Sprintf("Head tracker disconnecting from %v", ht.store.Config.EthereumURL()))
+	if chain, err := ht.chain(); err == nil {
+		logger.Info(fmt.Sprintf("Head tracker disconnecting from %v", chain.Config.EthereumURL()))
+	}
 	close(ht.done)
 	close(ht.subscriptionSucceeded)
 	ht.started = false
@@ -113,23 +138,87 @@ func (ht *HeadTracker) Stop() error {
 }
 
 // Save updates the latest block number, if indeed the latest, and persists
-// this number in case of reboot. Thread safe.
+// this number in case of reboot. A head that is not later than the current
+// head is still saved if its parent hash reveals that it belongs to a new
+// branch of the chain, in which case the heads of the old branch are
+// forgotten. Thread safe.
 func (ht *HeadTracker) Save(n *models.Head) error {
+	_, err := ht.save(n)
+	return err
+}
+
+func (ht *HeadTracker) save(n *models.Head) (*strpkg.Reorg, error) {
 	if n == nil {
-		return errors.New("Cannot save a nil block header")
+		return nil, errors.New("Cannot save a nil block header")
 	}
+	chain, err := ht.chain()
+	if err != nil {
+		return nil, err
+	}
+	chainID := chain.ID
+	n.ChainID = utils.NewBig(chainID)
 
 	ht.headMutex.Lock()
-	if n.GreaterThan(ht.head) {
-		copy := *n
-		ht.head = &copy
+	reorg, err := ht.detectReorg(chainID, n)
+	if err != nil {
 		ht.headMutex.Unlock()
-	} else {
+		return nil, errors.Wrap(err, "while checking for a reorg")
+	}
+	if reorg == nil && !n.GreaterThan(ht.head) {
 		ht.headMutex.Unlock()
 		msg := fmt.Sprintf("Cannot save new head confirmation %v because it's equal to or less than current head %v with hash %s", n, ht.head, n.Hash.Hex())
-		return errBlockNotLater{msg}
+		return nil, errBlockNotLater{msg}
+	}
+	copy := *n
+	ht.head = &copy
+	ht.headMutex.Unlock()
+
+	if reorg != nil {
+		if err := ht.store.DeleteHeadsAbove(chainID, reorg.ForkNumber()); err != nil {
+			return nil, errors.Wrap(err, "while deleting orphaned heads")
+		}
 	}
-	return ht.store.CreateHead(n)
+	return reorg, ht.store.CreateHead(n)
+}
+
+// detectReorg returns the reorganisation revealed by the head n, if any. Heads
+// without a parent hash are assumed to extend the current head.
+func (ht *HeadTracker) detectReorg(chainID *big.Int, n *models.Head) (*strpkg.Reorg, error) {
+	current := ht.head
+	if current == nil || n.ParentHash == (common.Hash{}) || n.ParentHash == current.Hash || n.Hash == current.Hash {
+		return nil, nil
+	}
+	known, err := ht.store.HeadByHash(chainID, n.Hash)
+	if err != nil || known != nil {
+		return nil, err
+	}
+
+	ancestor, err := ht.store.HeadByHash(chainID, n.ParentHash)
+	if err != nil {
+		return nil, err
+	}
+	if ancestor == nil {
+		if n.Number > current.Number+1 {
+			// Heads were missed, so the new head may well extend the current one
+			return nil, nil
+		}
+		// The new branch forked before the heads kept in history, so at least
+		// the current head and the one at the height of n's parent were replaced
+		return &strpkg.Reorg{
+			OldHead: current,
+			NewHead: n,
+			Depth:   current.Number - n.Number + 2,
+		}, nil
+	}
+	if ancestor.Number >= current.Number {
+		return nil, nil
+	}
+	return &strpkg.Reorg{
+		CommonAncestor: ancestor,
+		OldHead:        current,
+		NewHead:        n,
+		Depth:          current.Number - ancestor.Number,
+	}, nil
 }
 
 // Head returns the latest block header being tracked, or nil.
@@ -160,17 +249,40 @@ func (ht *HeadTracker) disconnect() {
 	}
 }
 
-func (ht *HeadTracker) onNewHead(head *models.Head) {
+func (ht *HeadTracker) onNewHead(head *models.Head, reorg *strpkg.Reorg) {
 	numberHeadsReceived.Inc()
 
 	ht.headMutex.Lock()
 	defer ht.headMutex.Unlock()
 
+	if reorg != nil {
+		ht.onReorg(*reorg)
+	}
 	for _, trackable := range ht.callbacks {
 		trackable.OnNewHead(head)
 	}
 }
 
+func (ht *HeadTracker) onReorg(reorg strpkg.Reorg) {
+	numberReorgs.Inc()
+	reorgDepth.Observe(float64(reorg.Depth))
+	logger.Warnw(
+		fmt.Sprintf("Chain reorganisation of depth %v detected", reorg.Depth),
+		"oldHead", reorg.OldHead.ToInt(),
+		"oldHash", reorg.OldHead.Hash.Hex(),
+		"newHead", reorg.NewHead.ToInt(),
+		"newHash", reorg.NewHead.Hash.Hex(),
+		"forkNumber", reorg.ForkNumber(),
+		"chainID", ht.chainID,
+	)
+
+	for _, trackable := range ht.callbacks {
+		if rt, ok := trackable.(strpkg.ReorgTrackable); ok {
+			rt.OnReorg(reorg)
+		}
+	}
+}
+
 func (ht *HeadTracker) listenForNewHeads() {
 	defer ht.listenForNewHeadsWg.Done()
 	defer ht.unsubscribeFromHead()
@@ -192,18 +304,24 @@ func (ht *HeadTracker) listenForNewHeads() {
 // It returns true on success, and false if cut short by a done request and did not connect.
 func (ht *HeadTracker) subscribe() bool {
 	ht.sleeper.Reset()
+	chain, err := ht.chain()
+	if err != nil {
+		logger.Error(err)
+		return false
+	}
+	ethereumURL := chain.Config.EthereumURL()
 	for {
 		ht.unsubscribeFromHead()
-		logger.Info("Connecting to ethereum node ", ht.store.Config.EthereumURL(), " in ", ht.sleeper.Duration())
+		logger.Info("Connecting to ethereum node ", ethereumURL, " in ", ht.sleeper.Duration())
 		select {
 		case <-ht.done:
 			return false
 		case <-time.After(ht.sleeper.After()):
 			err := ht.subscribeToHead()
 			if err != nil {
-				logger.Warnw(fmt.Sprintf("Failed to connect to ethereum node %v", ht.store.Config.EthereumURL()), "err", err)
+				logger.Warnw(fmt.Sprintf("Failed to connect to ethereum node %v", ethereumURL), "err", err)
 			} else {
-				logger.Info("Connected to ethereum node ", ht.store.Config.EthereumURL())
+				logger.Info("Connected to ethereum node ", ethereumURL)
 				return true
 			}
 		}
@@ -220,12 +338,14 @@ func (ht *HeadTracker) receiveHeaders() error {
 				return errors.New("HeadTracker headers prematurely closed")
 			}
 			head := models.NewHead(block.Number.ToInt(), block.Hash())
+			head.ParentHash = block.ParentHash
 			logger.Debugw(
 				fmt.Sprintf("Received new head %v", presenters.FriendlyBigInt(head.ToInt())),
 				"blockHeight", head.ToInt(),
 				"blockHash", block.Hash(),
 				"hash", head.Hash)
-			if err := ht.Save(head); err != nil {
+			reorg, err := ht.save(head)
+			if err != nil {
 				switch err.(type) {
 				case errBlockNotLater:
 					logger.Warn(err)
@@ -233,7 +353,7 @@ func (ht *HeadTracker) receiveHeaders() error {
 					logger.Error(err)
 				}
 			} else {
-				ht.onNewHead(head)
+				ht.onNewHead(head, reorg)
 			}
 		case err, open := <-ht.headSubscription.Err():
 			if open && err != nil {
@@ -248,8 +368,12 @@ func (ht *HeadTracker) subscribeToHead() error {
 	defer ht.headMutex.Unlock()
 
 	ctx := context.Background()
+	chain, err := ht.chain()
+	if err != nil {
+		return err
+	}
 	ht.headers = make(chan eth.BlockHeader)
-	sub, err := ht.store.TxManager.SubscribeToNewHeads(ctx, ht.headers)
+	sub, err := chain.TxManager.SubscribeToNewHeads(ctx, ht.headers)
 	if err != nil {
 		return errors.Wrap(err, "TxManager#SubscribeToNewHeads")
 	}
@@ -281,7 +405,11 @@ func (ht *HeadTracker) unsubscribeFromHead() error {
 }
 
 func (ht *HeadTracker) updateHeadFromDb() error {
-	number, err := ht.store.LastHead()
+	chain, err := ht.chain()
+	if err != nil {
+		return err
+	}
+	number, err := ht.store.LastHead(chain.ID)
 	if err != nil {
 		return err
 	}
@@ -297,18 +425,22 @@ func (e errBlockNotLater) Error() string {
 	return e.message
 }
 
-// chainIDVerify checks whether or not the ChainID from the Chainlink config
-// matches the ChainID reported by the ETH node connected to this Chainlink node.
+// chainIDVerify checks whether or not the ChainID of the tracked chain
+// matches the ChainID reported by the ETH node it is connected to.
 func verifyEthereumChainID(ht *HeadTracker) error {
-	ethereumChainID, err := ht.store.TxManager.GetChainID()
+	chain, err := ht.chain()
+	if err != nil {
+		return err
+	}
+	ethereumChainID, err := chain.TxManager.GetChainID()
 	if err != nil {
 		return err
 	}
 
-	if ethereumChainID.Cmp(ht.store.Config.ChainID()) != 0 {
+	if ethereumChainID.Cmp(chain.ID) != 0 {
 		return fmt.Errorf(
 			"ethereum ChainID doesn't match chainlink config.ChainID: config ID=%d, eth RPC ID=%d",
-			ht.store.Config.ChainID(),
+			chain.ID,
 			ethereumChainID,
 		)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/onsi/gomega"
@@ -27,16 +28,27 @@ func TestHeadTracker_New(t *testing.T) {
 	defer cleanup()
 	cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
 
-	assert.Nil(t, store.CreateHead(cltest.Head(1)))
-	last := cltest.Head(16)
+	assert.Nil(t, store.CreateHead(cltest.DefaultChainHead(store, 1)))
+	last := cltest.DefaultChainHead(store, 16)
 	assert.Nil(t, store.CreateHead(last))
-	assert.Nil(t, store.CreateHead(cltest.Head(10)))
+	assert.Nil(t, store.CreateHead(cltest.DefaultChainHead(store, 10)))
 
 	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{})
 	assert.Nil(t, ht.Start())
 	assert.Equal(t, last.Number, ht.Head().Number)
 }
 
+func TestHeadTracker_UnknownChain(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ht := services.NewChainHeadTracker(store, big.NewInt(424242), []strpkg.HeadTrackable{})
+	assert.Error(t, ht.Start())
+	assert.Error(t, ht.Save(cltest.Head(1)))
+}
+
 func TestHeadTracker_New_Limit_At_100(t *testing.T) {
 	t.Parallel()
 
@@ -46,9 +58,9 @@ func TestHeadTracker_New_Limit_At_100(t *testing.T) {
 	cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
 
 	for idx := 0; idx <= 200; idx++ {
-		assert.Nil(t, store.CreateHead(cltest.Head(idx)))
+		assert.Nil(t, store.CreateHead(cltest.DefaultChainHead(store, idx)))
 	}
-	firstHead, err := store.FirstHead()
+	firstHead, err := store.FirstHead(store.Config.ChainID())
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(101), firstHead.ToInt())
 	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{})
@@ -82,6 +94,7 @@ func TestHeadTracker_Get(t *testing.T) {
 
 			cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
 			if test.initial != nil {
+				test.initial.ChainID = utils.NewBig(store.Config.ChainID())
 				assert.Nil(t, store.CreateHead(test.initial))
 			}
 
@@ -212,3 +225,79 @@ func TestHeadTracker_StartConnectsFromLastSavedHeader(t *testing.T) {
 	g.Eventually(func() *big.Int { return ht.Head().ToInt() }).Should(gomega.Equal(currentBN))
 	assert.NoError(t, ht.Stop())
 }
+
+func TestHeadTracker_Save_Reorg(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
+
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{})
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+
+	h1 := cltest.Head(1)
+	h2 := cltest.Head(2)
+	h2.ParentHash = h1.Hash
+	h3 := cltest.Head(3)
+	h3.ParentHash = h2.Hash
+	for _, head := range []*models.Head{h1, h2, h3} {
+		require.NoError(t, ht.Save(head))
+	}
+
+	// A competing head at the same height replaces the current head
+	uncle := cltest.Head(3)
+	uncle.ParentHash = h2.Hash
+	require.NoError(t, ht.Save(uncle))
+	assert.Equal(t, uncle.Hash, ht.Head().Hash)
+
+	// A lower head replaces every head of the old branch after the fork
+	lower := cltest.Head(2)
+	lower.ParentHash = h1.Hash
+	require.NoError(t, ht.Save(lower))
+	assert.Equal(t, lower.Hash, ht.Head().Hash)
+
+	last, err := store.LastHead(store.Config.ChainID())
+	require.NoError(t, err)
+	assert.Equal(t, lower.Hash, last.Hash)
+	orphan, err := store.HeadByHash(store.Config.ChainID(), h3.Hash)
+	require.NoError(t, err)
+	assert.Nil(t, orphan)
+
+	// Heads without a parent hash must still be later than the current head
+	assert.Error(t, ht.Save(cltest.Head(2)))
+}
+
+func TestHeadTracker_ReorgTrackableCallbacks(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	mocketh := cltest.MockEthOnStore(t, store, cltest.EthMockRegisterChainID)
+
+	checker := &cltest.MockHeadTrackable{}
+	ht := services.NewHeadTracker(store, []strpkg.HeadTrackable{checker}, cltest.NeverSleeper{})
+
+	headers := make(chan eth.BlockHeader)
+	mocketh.RegisterSubscription("newHeads", headers)
+	mocketh.Register("eth_chainId", store.Config.ChainID())
+
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+	g.Eventually(func() int32 { return checker.ConnectedCount() }).Should(gomega.Equal(int32(1)))
+
+	h1, h2, uncle := cltest.NewHash(), cltest.NewHash(), cltest.NewHash()
+	headers <- eth.BlockHeader{Number: cltest.BigHexInt(1), ParityHash: h1}
+	headers <- eth.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: h2, ParentHash: h1}
+	headers <- eth.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: uncle, ParentHash: h1}
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(3)))
+
+	reorgs := checker.Reorgs()
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, int64(1), reorgs[0].Depth)
+	assert.Equal(t, h1, reorgs[0].CommonAncestor.Hash)
+	assert.Equal(t, h2, reorgs[0].OldHead.Hash)
+	assert.Equal(t, uncle, reorgs[0].NewHead.Hash)
+}
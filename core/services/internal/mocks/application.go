@@ -11,6 +11,8 @@ import (
 
 	packr "github.com/gobuffalo/packr"
 
+	services "github.com/smartcontractkit/chainlink/core/services"
+
 	store "github.com/smartcontractkit/chainlink/core/store"
 
 	synchronization "github.com/smartcontractkit/chainlink/core/services/synchronization"
@@ -132,6 +134,22 @@ func (_m *Application) CreateErrored(jobSpecID *models.ID, initiator models.Init
 	return r0, r1
 }
 
+// GetBalanceMonitor provides a mock function with given fields: chainID
+func (_m *Application) GetBalanceMonitor(chainID *big.Int) services.BalanceMonitor {
+	ret := _m.Called(chainID)
+
+	var r0 services.BalanceMonitor
+	if rf, ok := ret.Get(0).(func(*big.Int) services.BalanceMonitor); ok {
+		r0 = rf(chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(services.BalanceMonitor)
+		}
+	}
+
+	return r0
+}
+
 // GetStatsPusher provides a mock function with given fields:
 func (_m *Application) GetStatsPusher() synchronization.StatsPusher {
 	ret := _m.Called()
@@ -206,13 +224,13 @@ func (_m *Application) ResumeAllPendingConnection() error {
 	return r0
 }
 
-// ResumeAllPendingNextBlock provides a mock function with given fields: currentBlockHeight
-func (_m *Application) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error {
-	ret := _m.Called(currentBlockHeight)
+// ResumeAllPendingNextBlock provides a mock function with given fields: chainID, currentBlockHeight
+func (_m *Application) ResumeAllPendingNextBlock(chainID *big.Int, currentBlockHeight *big.Int) error {
+	ret := _m.Called(chainID, currentBlockHeight)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(currentBlockHeight)
+	if rf, ok := ret.Get(0).(func(*big.Int, *big.Int) error); ok {
+		r0 = rf(chainID, currentBlockHeight)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -36,6 +36,7 @@ type JobSubscriber interface {
 // jobSubscriber implementation
 type jobSubscriber struct {
 	store                     *store.Store
+	chainID                   *big.Int
 	jobSubscriptions          map[string]JobSubscription
 	jobsMutex                 *sync.RWMutex
 	runManager                RunManager
@@ -45,21 +46,30 @@ type jobSubscriber struct {
 
 type resumeRunsOnNewHeadWorker struct {
 	runManager RunManager
+	chainID    *big.Int
 	head       big.Int
 }
 
 func (rw *resumeRunsOnNewHeadWorker) Work() {
-	err := rw.runManager.ResumeAllPendingNextBlock(&rw.head)
+	err := rw.runManager.ResumeAllPendingNextBlock(rw.chainID, &rw.head)
 	if err != nil {
 		logger.Errorw("Failed to resume confirming tasks on new head", "error", err)
 	}
 }
 
-// NewJobSubscriber returns a new job subscriber.
+// NewJobSubscriber returns a new job subscriber for the default chain.
 func NewJobSubscriber(store *store.Store, runManager RunManager) JobSubscriber {
-	rw := &resumeRunsOnNewHeadWorker{runManager: runManager}
+	return NewChainJobSubscriber(store, nil, runManager)
+}
+
+// NewChainJobSubscriber returns a new job subscriber listening for the logs
+// of the initiators bound to the given chain, or to the default chain if
+// chainID is nil.
+func NewChainJobSubscriber(store *store.Store, chainID *big.Int, runManager RunManager) JobSubscriber {
+	rw := &resumeRunsOnNewHeadWorker{runManager: runManager, chainID: chainID}
 	js := &jobSubscriber{
 		store:                     store,
+		chainID:                   chainID,
 		runManager:                runManager,
 		jobSubscriptions:          map[string]JobSubscription{},
 		jobsMutex:                 &sync.RWMutex{},
@@ -74,13 +84,21 @@ func (js *jobSubscriber) Stop() error {
 }
 
 // AddJob subscribes to ethereum log events for each "runlog" and "ethlog"
-// initiator in the passed job spec.
+// initiator in the passed job spec bound to the subscriber's chain.
 func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.Head) error {
 	if !job.IsLogInitiated() {
 		return nil
 	}
 
-	sub, err := StartJobSubscription(job, bn, js.store, js.runManager)
+	chain, err := resolveChain(js.store, js.chainID)
+	if err != nil {
+		return err
+	}
+	if len(chainLogInitiators(job, js.store, chain)) == 0 {
+		return nil
+	}
+
+	sub, err := StartChainJobSubscription(job, bn, js.store, chain, js.runManager)
 	if err != nil {
 		return err
 	}
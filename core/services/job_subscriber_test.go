@@ -30,14 +30,14 @@ func TestJobSubscriber_OnNewHead(t *testing.T) {
 	wg.Add(1)
 	resumeJobChannel := make(chan struct{})
 
-	runManager.On("ResumeAllPendingNextBlock", big.NewInt(1337)).
+	runManager.On("ResumeAllPendingNextBlock", (*big.Int)(nil), big.NewInt(1337)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
 			wg.Done()
 			resumeJobChannel <- struct{}{}
 		})
-	runManager.On("ResumeAllPendingNextBlock", big.NewInt(1339)).
+	runManager.On("ResumeAllPendingNextBlock", (*big.Int)(nil), big.NewInt(1339)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
@@ -61,7 +61,7 @@ func TestJobSubscriber_OnNewHead(t *testing.T) {
 	})
 
 	// Make sure after dropping a head (because of congestion) that it resumes again
-	runManager.On("ResumeAllPendingNextBlock", big.NewInt(1340)).
+	runManager.On("ResumeAllPendingNextBlock", (*big.Int)(nil), big.NewInt(1340)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v3"
+)
+
+// StartLogReplay records a replay of the requested block range and runs it in
+// the background, saving its counts and status once done.
+func StartLogReplay(store *strpkg.Store, runManager RunManager, request models.LogReplayRequest) (models.LogReplay, error) {
+	if err := models.ValidateLogReplayRequest(&request); err != nil {
+		return models.LogReplay{}, err
+	}
+	if _, err := store.Chain(request.ChainID.ToInt()); err != nil {
+		return models.LogReplay{}, err
+	}
+
+	replay := models.NewLogReplay(request)
+	if err := store.CreateLogReplay(&replay); err != nil {
+		return models.LogReplay{}, errors.Wrap(err, "recording log replay")
+	}
+	started := replay
+
+	go func() {
+		if err := ReplayLogs(store, runManager, &replay); err != nil {
+			logger.Errorw("Log replay failed", "replay", replay.ID, "error", err)
+			replay.Status = models.LogReplayStatusErrored
+			replay.Error = null.StringFrom(err.Error())
+		} else {
+			replay.Status = models.LogReplayStatusCompleted
+		}
+		if err := store.SaveLogReplay(&replay); err != nil {
+			logger.Errorw("Unable to save log replay", "replay", replay.ID, "error", err)
+		}
+	}()
+	return started, nil
+}
+
+// ReplayLogs scans the historical logs of the replay's block range for the
+// log initiators of its job, or of every job, bound to its chain. Logs that
+// were not already consumed by their job are processed as if they had just
+// been received, creating the missing runs. The logs are counted in the
+// replay.
+func ReplayLogs(store *strpkg.Store, runManager RunManager, replay *models.LogReplay) error {
+	chain, err := store.Chain(replay.ChainID.ToInt())
+	if err != nil {
+		return err
+	}
+
+	var jobs []models.JobSpec
+	if replay.JobID != nil {
+		job, err := store.FindJob(replay.JobID)
+		if err != nil {
+			return errors.Wrap(err, "finding job to replay")
+		}
+		jobs = append(jobs, job)
+	} else {
+		err = store.Jobs(
+			func(j *models.JobSpec) bool {
+				jobs = append(jobs, *j)
+				return true
+			},
+			models.LogBasedChainlinkJobInitiators...,
+		)
+		if err != nil {
+			return errors.Wrap(err, "loading jobs to replay")
+		}
+	}
+
+	fromBlock := new(big.Int).SetUint64(replay.FromBlock)
+	toBlock := new(big.Int).SetUint64(replay.ToBlock)
+
+	var merr error
+	for _, job := range jobs {
+		for _, initr := range chainLogInitiators(job, store, chain) {
+			filter, err := models.FilterQueryFactory(initr, fromBlock)
+			if err != nil {
+				merr = multierr.Append(merr, errors.Wrapf(err, "replaying %s initiator of job %s", initr.Type, job.ID.String()))
+				continue
+			}
+			if filter.ToBlock == nil || filter.ToBlock.Cmp(toBlock) > 0 {
+				filter.ToBlock = toBlock
+			}
+			if filter.FromBlock.Cmp(filter.ToBlock) > 0 {
+				continue
+			}
+
+			logs, err := chain.TxManager.GetLogs(filter)
+			if err != nil {
+				merr = multierr.Append(merr, errors.Wrapf(err, "fetching logs for %s initiator of job %s", initr.Type, job.ID.String()))
+				continue
+			}
+
+			for _, log := range logs {
+				replay.LogsFound++
+				consumed, err := store.HasConsumedLog(log, job.ID)
+				if err != nil {
+					merr = multierr.Append(merr, err)
+					continue
+				}
+				if consumed || log.Removed {
+					replay.LogsSkipped++
+					continue
+				}
+
+				le := models.InitiatorLogEvent{Initiator: initr, Log: log}.LogRequest()
+				if !ReceiveLogRequest(runManager, le) {
+					replay.LogsSkipped++
+					continue
+				}
+				recordLogConsumption(store, le)
+				replay.LogsReplayed++
+			}
+		}
+	}
+
+	logger.Infow("Replayed logs",
+		"replay", replay.ID,
+		"fromBlock", replay.FromBlock,
+		"toBlock", replay.ToBlock,
+		"chainID", chain.ID.String(),
+		"found", replay.LogsFound,
+		"replayed", replay.LogsReplayed,
+		"skipped", replay.LogsSkipped,
+	)
+	return merr
+}
@@ -0,0 +1,113 @@
+package services_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	ethpkg "github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServices_ReplayLogs_SkipsConsumedLogs(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	consumedLog := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	missedLog := consumedLog
+	missedLog.BlockHash = cltest.NewHash()
+	missedLog.Index = consumedLog.Index + 1
+	lc := models.NewLogConsumption(consumedLog, job.ID)
+	require.NoError(t, store.CreateLogConsumption(&lc))
+
+	txManager := new(mocks.TxManager)
+	store.TxManager = txManager
+	txManager.On("GetLogs", mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+		return q.FromBlock.Cmp(big.NewInt(10)) == 0 && q.ToBlock.Cmp(big.NewInt(20)) == 0
+	})).Return([]ethpkg.Log{consumedLog, missedLog}, nil)
+
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, big.NewInt(int64(missedLog.BlockNumber)), mock.Anything).
+		Return(nil, nil).
+		Once()
+
+	replay := models.LogReplay{FromBlock: 10, ToBlock: 20, JobID: job.ID}
+	require.NoError(t, services.ReplayLogs(store, runManager, &replay))
+	assert.Equal(t, 2, replay.LogsFound)
+	assert.Equal(t, 1, replay.LogsReplayed)
+	assert.Equal(t, 1, replay.LogsSkipped)
+
+	consumed, err := store.HasConsumedLog(missedLog, job.ID)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+
+	txManager.AssertExpectations(t)
+	runManager.AssertExpectations(t)
+}
+
+func TestServices_ReplayLogs_CountsRejectedLogsAsSkipped(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	acceptedLog := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	rejectedLog := acceptedLog
+	rejectedLog.BlockHash = cltest.NewHash()
+	rejectedLog.BlockNumber = acceptedLog.BlockNumber + 1
+
+	txManager := new(mocks.TxManager)
+	store.TxManager = txManager
+	txManager.On("GetLogs", mock.Anything).Return([]ethpkg.Log{acceptedLog, rejectedLog}, nil)
+
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, big.NewInt(int64(acceptedLog.BlockNumber)), mock.Anything).
+		Return(nil, nil).
+		Once()
+	runManager.On("Create", job.ID, mock.Anything, big.NewInt(int64(rejectedLog.BlockNumber)), mock.Anything).
+		Return(nil, errors.New("job run rejected")).
+		Once()
+
+	replay := models.LogReplay{FromBlock: 10, ToBlock: 20, JobID: job.ID}
+	require.NoError(t, services.ReplayLogs(store, runManager, &replay))
+	assert.Equal(t, 2, replay.LogsFound)
+	assert.Equal(t, 1, replay.LogsReplayed)
+	assert.Equal(t, 1, replay.LogsSkipped)
+
+	consumed, err := store.HasConsumedLog(rejectedLog, job.ID)
+	require.NoError(t, err)
+	assert.False(t, consumed)
+
+	txManager.AssertExpectations(t)
+	runManager.AssertExpectations(t)
+}
+
+func TestServices_ReplayLogs_InvalidRange(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	from, to := uint64(20), uint64(10)
+	_, err := services.StartLogReplay(store, new(mocks.RunManager), models.LogReplayRequest{FromBlock: &from, ToBlock: &to})
+	assert.Error(t, err)
+
+	_, err = services.StartLogReplay(store, new(mocks.RunManager), models.LogReplayRequest{FromBlock: &from})
+	assert.Error(t, err)
+}
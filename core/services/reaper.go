@@ -13,7 +13,8 @@ type storeReaper struct {
 	config orm.ConfigReader
 }
 
-// NewStoreReaper creates a reaper that cleans stale objects from the store.
+// NewStoreReaper creates a reaper that cleans stale objects, such as expired
+// sessions, old log consumptions and flux monitor rounds, from the store.
 func NewStoreReaper(store *store.Store) SleeperTask {
 	return NewSleeperTask(&storeReaper{
 		store:  store,
@@ -28,4 +29,16 @@ func (sr *storeReaper) Work() {
 	if err != nil {
 		logger.Error("unable to reap stale sessions: ", err)
 	}
+
+	logConsumptionThreshold := time.Now().Add(-sr.config.LogConsumptionRetention().Duration())
+	err = sr.store.DeleteLogConsumptionsBefore(logConsumptionThreshold)
+	if err != nil {
+		logger.Error("unable to reap old log consumptions: ", err)
+	}
+
+	fluxMonitorRoundThreshold := time.Now().Add(-sr.config.FluxMonitorRoundsRetention().Duration())
+	err = sr.store.DeleteFluxMonitorRoundsBefore(fluxMonitorRoundThreshold)
+	if err != nil {
+		logger.Error("unable to reap old flux monitor rounds: ", err)
+	}
 }
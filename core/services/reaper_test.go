@@ -60,3 +60,68 @@ func TestStoreReaper_ReapSessions(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreReaper_ReapLogConsumptions(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	r := services.NewStoreReaper(store)
+	defer r.Stop()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	oldLog := cltest.LogFromFixture(t, "testdata/subscription_logs.json")
+	recentLog := oldLog
+	recentLog.BlockHash = cltest.NewHash()
+
+	old := models.NewLogConsumption(oldLog, job.ID)
+	old.CreatedAt = time.Now().Add(-store.Config.LogConsumptionRetention().Duration()).Add(-time.Hour)
+	require.NoError(t, store.CreateLogConsumption(&old))
+	recent := models.NewLogConsumption(recentLog, job.ID)
+	require.NoError(t, store.CreateLogConsumption(&recent))
+
+	r.WakeUp()
+
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		consumed, err := store.HasConsumedLog(oldLog, job.ID)
+		assert.NoError(t, err)
+		return consumed
+	}).Should(gomega.BeFalse())
+
+	consumed, err := store.HasConsumedLog(recentLog, job.ID)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+}
+
+func TestStoreReaper_ReapFluxMonitorRounds(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	r := services.NewStoreReaper(store)
+	defer r.Stop()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	old := models.NewFluxMonitorRound(job.ID, 1, models.FluxMonitorTriggerPoll)
+	old.CreatedAt = time.Now().Add(-store.Config.FluxMonitorRoundsRetention().Duration()).Add(-time.Hour)
+	require.NoError(t, store.CreateFluxMonitorRound(old))
+	recent := models.NewFluxMonitorRound(job.ID, 2, models.FluxMonitorTriggerPoll)
+	require.NoError(t, store.CreateFluxMonitorRound(recent))
+
+	r.WakeUp()
+
+	gomega.NewGomegaWithT(t).Eventually(func() []models.FluxMonitorRound {
+		rounds, _, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+		assert.NoError(t, err)
+		return rounds
+	}).Should(gomega.HaveLen(1))
+
+	rounds, _, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, rounds, 1)
+	assert.Equal(t, recent.ID, rounds[0].ID)
+}
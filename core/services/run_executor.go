@@ -63,7 +63,7 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 			continue
 		}
 
-		if meetsMinRequiredIncomingConfirmations(&run, taskRun, run.ObservedHeight) {
+		if meetsMinRequiredIncomingConfirmations(&run, taskRun, run.ObservedHeight) && re.validateBeforeBroadcast(&run, taskRun) {
 			start := time.Now()
 
 			// NOTE: adapters may define and return the new job run status in here
@@ -76,7 +76,7 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 
 			logger.Debugw(fmt.Sprintf("Executed task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String(), "elapsed", elapsed)...)
 
-		} else {
+		} else if !run.GetStatus().Finished() && !run.GetStatus().PendingIncomingConfirmations() {
 			logger.Debugw("Pausing run pending incoming confirmations",
 				run.ForLogger("required_height", taskRun.MinRequiredIncomingConfirmations)...,
 			)
@@ -105,6 +105,25 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 	return nil
 }
 
+// validateBeforeBroadcast checks, before a task sending a transaction is
+// first performed, that the log initiating the run was not reorged out since
+// the run left pending_incoming_confirmations.
+func (re *runExecutor) validateBeforeBroadcast(run *models.JobRun, taskRun *models.TaskRun) bool {
+	switch taskRun.TaskSpec.Type {
+	case adapters.TaskTypeEthTx, adapters.TaskTypeEthTxABIEncode:
+	default:
+		return true
+	}
+	if taskRun.Status.PendingOutgoingConfirmations() {
+		return true
+	}
+	chain, err := re.store.Chain(run.Initiator.ChainID.ToInt())
+	if err != nil {
+		return true
+	}
+	return validateOnMainChain(run, taskRun, chain.TxManager)
+}
+
 func (re *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun) models.RunOutput {
 	taskCopy := taskRun.TaskSpec // deliberately copied to keep mutations local
 
@@ -114,6 +133,11 @@ func (re *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 	}
 	taskCopy.Params = params
 
+	taskCopy.Params, err = withInitiatorChainID(taskCopy, run.Initiator)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
 	adapter, err := adapters.For(taskCopy, re.store.Config, re.store.ORM)
 	if err != nil {
 		return models.NewRunOutputError(err)
@@ -137,3 +161,17 @@ func (re *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun)
 
 	return result
 }
+
+// withInitiatorChainID sends the transactions of a run on the chain its
+// initiator is bound to, unless the task names a chain itself.
+func withInitiatorChainID(task models.TaskSpec, initiator models.Initiator) (models.JSON, error) {
+	if initiator.ChainID == nil || task.Params.Get("chainId").Exists() {
+		return task.Params, nil
+	}
+	switch task.Type {
+	case adapters.TaskTypeEthTx, adapters.TaskTypeEthTxABIEncode:
+		return task.Params.Add("chainId", initiator.ChainID)
+	default:
+		return task.Params, nil
+	}
+}
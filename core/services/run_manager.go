@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 )
 
 // RecurringScheduleJobError contains the field for the error message.
@@ -48,7 +49,7 @@ type RunManager interface {
 	Cancel(runID *models.ID) (*models.JobRun, error)
 
 	ResumeAllInProgress() error
-	ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error
+	ResumeAllPendingNextBlock(chainID *big.Int, currentBlockHeight *big.Int) error
 	ResumeAllPendingConnection() error
 }
 
@@ -57,7 +58,7 @@ type runManager struct {
 	orm         *orm.ORM
 	statsPusher synchronization.StatsPusher
 	runQueue    RunQueue
-	txManager   store.TxManager
+	chains      []store.Chain
 	config      orm.ConfigReader
 	clock       utils.AfterNower
 }
@@ -95,6 +96,15 @@ func NewRun(
 	run := models.MakeJobRun(job, now, initiator, currentHeight, runRequest)
 	runAdapters := []*adapters.PipelineAdapter{}
 
+	if initiator.Type == models.InitiatorFluxMonitor && initiator.ComputesAnswerFromTasks() {
+		// The flux monitor already ran the off-chain tasks to compute the
+		// answer passed in the request, so they are not performed again.
+		for i := range adapters.OffChainTasks(job.Tasks) {
+			run.TaskRuns[i].Status = models.RunStatusCompleted
+			run.TaskRuns[i].Result.Data = runRequest.RequestParams
+		}
+	}
+
 	for i, task := range job.Tasks {
 		adapter, err := adapters.For(task, config, orm)
 		if err != nil {
@@ -136,7 +146,7 @@ func ValidateRun(run *models.JobRun, contractCost *assets.Link) {
 	}
 }
 
-// NewRunManager returns a new job manager
+// NewRunManager returns a new job manager running jobs on the default chain
 func NewRunManager(
 	runQueue RunQueue,
 	config orm.ConfigReader,
@@ -144,16 +154,42 @@ func NewRunManager(
 	statsPusher synchronization.StatsPusher,
 	txManager store.TxManager,
 	clock utils.AfterNower) RunManager {
+	defaultChain := store.Chain{ID: config.ChainID(), Config: config, TxManager: txManager}
+	return NewChainRunManager(runQueue, config, orm, statsPusher, []store.Chain{defaultChain}, clock)
+}
+
+// NewChainRunManager returns a new job manager running jobs on the given
+// chains, the first of which is the default chain
+func NewChainRunManager(
+	runQueue RunQueue,
+	config orm.ConfigReader,
+	orm *orm.ORM,
+	statsPusher synchronization.StatsPusher,
+	chains []store.Chain,
+	clock utils.AfterNower) RunManager {
 	return &runManager{
 		orm:         orm,
 		statsPusher: statsPusher,
 		runQueue:    runQueue,
-		txManager:   txManager,
+		chains:      chains,
 		config:      config,
 		clock:       clock,
 	}
 }
 
+// chain returns the chain a run initiated by the given initiator runs on.
+func (rm *runManager) chain(initiator models.Initiator) (store.Chain, error) {
+	if initiator.ChainID == nil {
+		return rm.chains[0], nil
+	}
+	for _, chain := range rm.chains {
+		if chain.ID.Cmp(initiator.ChainID.ToInt()) == 0 {
+			return chain, nil
+		}
+	}
+	return store.Chain{}, fmt.Errorf("chain %s is not served by this node", initiator.ChainID)
+}
+
 // CreateErrored creates a run that is in the errored state. This is a
 // special case where this job cannot run but we want to create the run record
 // so the error is more visible to the node operator.
@@ -221,7 +257,12 @@ func (rm *runManager) Create(
 		return nil, fmt.Errorf("invariant for job %s: no tasks to run in NewRun", job.ID)
 	}
 
-	run, adapters := NewRun(&job, initiator, creationHeight, runRequest, rm.config, rm.orm, now)
+	chain, err := rm.chain(*initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	run, adapters := NewRun(&job, initiator, creationHeight, runRequest, chain.Config, rm.orm, now)
 	runCost := runCost(&job, rm.config, adapters)
 	ValidateRun(run, runCost)
 
@@ -240,10 +281,22 @@ func (rm *runManager) Create(
 	return run, nil
 }
 
-// ResumeAllPendingNextBlock wakes up all jobs that were sleeping because they
-// were waiting for the next block
-func (rm *runManager) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error {
+// ResumeAllPendingNextBlock wakes up all jobs on the given chain, or on the
+// default chain if chainID is nil, that were sleeping because they were
+// waiting for the next block
+func (rm *runManager) ResumeAllPendingNextBlock(chainID *big.Int, currentBlockHeight *big.Int) error {
+	if chainID == nil {
+		chainID = rm.chains[0].ID
+	}
 	return rm.orm.UnscopedJobRunsWithStatus(func(run *models.JobRun) {
+		chain, err := rm.chain(run.Initiator)
+		if err != nil {
+			rm.updateWithError(run, "Attempting to resume run %s on unknown chain: %v", run.ID, err)
+			return
+		} else if chain.ID.Cmp(chainID) != 0 {
+			return
+		}
+
 		currentTaskRun := run.NextTaskRun()
 		if currentTaskRun == nil {
 			rm.updateWithError(run, "Attempting to resume confirming run with no remaining tasks %s", run.ID)
@@ -255,10 +308,10 @@ func (rm *runManager) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) err
 
 		// Set jobRun status in progress if met minimum incoming confirmations
 		// Task run status will be set later in runManager#Execute
-		markInProgressIfSufficientIncomingConfirmations(run, currentTaskRun, run.ObservedHeight, rm.txManager)
+		markInProgressIfSufficientIncomingConfirmations(run, currentTaskRun, run.ObservedHeight, chain.TxManager)
 
 		// Save job run and resume if status was set to InProgress
-		err := rm.saveAndResumeIfInProgress(run)
+		err = rm.saveAndResumeIfInProgress(run)
 		if err != nil {
 			logger.Errorw("Error saving run", run.ForLogger("error", err)...)
 		}
@@ -350,7 +403,55 @@ func (rm *runManager) Cancel(runID *models.ID) (*models.JobRun, error) {
 
 	run.Cancel()
 	defer rm.statsPusher.PushNow()
-	return &run, rm.orm.SaveJobRun(&run)
+	if err := rm.orm.SaveJobRun(&run); err != nil {
+		return &run, err
+	}
+	if err := rm.cancelTxs(&run); err != nil {
+		return &run, errors.Wrap(err, "run was cancelled but not its transactions")
+	}
+	return &run, nil
+}
+
+// cancelTxs replaces the unconfirmed transactions sent by the run by no-ops,
+// so that they cannot land after the run is cancelled. Transactions batching
+// the calls of several runs cannot be cancelled on behalf of one of them.
+func (rm *runManager) cancelTxs(run *models.JobRun) error {
+	var merr error
+	call, err := rm.orm.FindTxBatchCall(run.ID)
+	if err == nil && call.Error.IsZero() {
+		if !call.Sent() {
+			merr = multierr.Append(merr, errors.New("the call of the run is still queued for a batched transaction, which cannot be cancelled"))
+		} else if tx, err := rm.orm.FindTx(*call.TxID); err != nil {
+			merr = multierr.Append(merr, errors.Wrap(err, "finding batched transaction of run"))
+		} else if !tx.Confirmed {
+			merr = multierr.Append(merr, fmt.Errorf("transaction %d batches the calls of several runs and cannot be cancelled", tx.ID))
+		}
+	} else if err != nil && errors.Cause(err) != orm.ErrorNotFound {
+		merr = multierr.Append(merr, errors.Wrap(err, "finding batched call of run"))
+	}
+
+	txs, err := rm.orm.TxsBySurrogateID(run.ID.String())
+	if err != nil {
+		return multierr.Append(merr, errors.Wrap(err, "finding transactions of run"))
+	}
+	for i := range txs {
+		tx := &txs[i]
+		if tx.Confirmed || tx.Cancellation != models.TxCancellationNone {
+			continue
+		}
+		merr = multierr.Append(merr, rm.cancelTx(tx))
+	}
+	return merr
+}
+
+func (rm *runManager) cancelTx(tx *models.Tx) error {
+	for _, chain := range rm.chains {
+		if tx.ChainID != nil && chain.ID.Cmp(tx.ChainID.ToInt()) != 0 {
+			continue
+		}
+		return errors.Wrapf(chain.TxManager.CancelTx(tx), "cancelling transaction %d", tx.ID)
+	}
+	return fmt.Errorf("unable to cancel transaction %d on chain %s not served by this node", tx.ID, tx.ChainID)
 }
 
 func (rm *runManager) updateWithError(run *models.JobRun, msg string, args ...interface{}) error {
@@ -142,7 +142,7 @@ func TestRunManager_ResumeAllPendingNextBlock(t *testing.T) {
 		run.SetStatus(models.RunStatusPendingIncomingConfirmations)
 		require.NoError(t, store.CreateJobRun(&run))
 
-		err := runManager.ResumeAllPendingNextBlock(nil)
+		err := runManager.ResumeAllPendingNextBlock(nil, nil)
 		assert.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -156,7 +156,7 @@ func TestRunManager_ResumeAllPendingNextBlock(t *testing.T) {
 		run.TaskRuns[0].MinRequiredIncomingConfirmations = clnull.Uint32From(2)
 		require.NoError(t, store.CreateJobRun(&run))
 
-		err := runManager.ResumeAllPendingNextBlock(big.NewInt(0))
+		err := runManager.ResumeAllPendingNextBlock(nil, big.NewInt(0))
 		require.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -172,7 +172,7 @@ func TestRunManager_ResumeAllPendingNextBlock(t *testing.T) {
 		require.NoError(t, store.CreateJobRun(&run))
 
 		observedHeight := big.NewInt(1)
-		err := runManager.ResumeAllPendingNextBlock(observedHeight)
+		err := runManager.ResumeAllPendingNextBlock(nil, observedHeight)
 		require.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -183,6 +183,102 @@ func TestRunManager_ResumeAllPendingNextBlock(t *testing.T) {
 	runQueue.AssertExpectations(t)
 }
 
+func TestRunManager_Cancel_CancelsPendingTx(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+	runQueue := new(mocks.RunQueue)
+	txManager := new(mocks.TxManager)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, txManager, store.Clock)
+
+	run := makeJobRunWithInitiator(t, store, cltest.NewJob())
+	run.SetStatus(models.RunStatusPendingOutgoingConfirmations)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	tx := cltest.CreateTx(t, store, cltest.NewAddress(), 1)
+	tx.SurrogateID = null.StringFrom(run.ID.String())
+	require.NoError(t, store.SaveTx(tx))
+	txManager.On("CancelTx", mock.MatchedBy(func(cancelled *models.Tx) bool {
+		return cancelled.ID == tx.ID
+	})).Once().Return(nil)
+
+	cancelled, err := runManager.Cancel(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, cancelled.GetStatus())
+
+	txManager.AssertExpectations(t)
+}
+
+func TestRunManager_Cancel_CancelsEveryPendingTx(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+	runQueue := new(mocks.RunQueue)
+	txManager := new(mocks.TxManager)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, txManager, store.Clock)
+
+	run := makeJobRunWithInitiator(t, store, cltest.NewJob())
+	run.SetStatus(models.RunStatusPendingOutgoingConfirmations)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	from := cltest.NewAddress()
+	confirmed := cltest.CreateTx(t, store, from, 1)
+	confirmed.SurrogateID = null.StringFrom(run.ID.String())
+	confirmed.Confirmed = true
+	require.NoError(t, store.SaveTx(confirmed))
+	var pending []*models.Tx
+	for sentAt := uint64(2); sentAt <= 3; sentAt++ {
+		tx := cltest.CreateTxWithNonceAndGasPrice(t, store, from, sentAt, sentAt, 1)
+		tx.SurrogateID = null.StringFrom(run.ID.String())
+		require.NoError(t, store.SaveTx(tx))
+		pending = append(pending, tx)
+	}
+	for _, tx := range pending {
+		id := tx.ID
+		txManager.On("CancelTx", mock.MatchedBy(func(cancelled *models.Tx) bool {
+			return cancelled.ID == id
+		})).Once().Return(nil)
+	}
+
+	_, err := runManager.Cancel(run.ID)
+	require.NoError(t, err)
+
+	txManager.AssertExpectations(t)
+	txManager.AssertNotCalled(t, "CancelTx", mock.MatchedBy(func(cancelled *models.Tx) bool {
+		return cancelled.ID == confirmed.ID
+	}))
+}
+
+func TestRunManager_Cancel_BatchedTx(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+	runQueue := new(mocks.RunQueue)
+	txManager := new(mocks.TxManager)
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, txManager, store.Clock)
+
+	run := makeJobRunWithInitiator(t, store, cltest.NewJob())
+	run.SetStatus(models.RunStatusPendingOutgoingConfirmations)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	tx := cltest.CreateTx(t, store, cltest.NewAddress(), 1)
+	call := models.TxBatchCall{JobRunID: run.ID, TxID: &tx.ID}
+	require.NoError(t, store.CreateTxBatchCall(&call))
+
+	cancelled, err := runManager.Cancel(run.ID)
+	assert.Error(t, err)
+	require.NotNil(t, cancelled)
+	assert.Equal(t, models.RunStatusCancelled, cancelled.GetStatus())
+
+	txManager.AssertNotCalled(t, "CancelTx", mock.Anything)
+}
+
 func TestRunManager_ResumeAllPendingConnection(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -309,24 +405,62 @@ func TestRunManager_Create_fromRunLog_Happy(t *testing.T) {
 	initiatingTxHash := cltest.NewHash()
 	triggeringBlockHash := cltest.NewHash()
 	otherBlockHash := cltest.NewHash()
+	requestID := common.HexToHash("0xcafe")
+	runLog := cltest.NewRunLog(t, models.NewID(), cltest.NewAddress(), cltest.NewAddress(), 1, `{}`)
+	runLogRequestID, err := models.ParseRequestID(runLog)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name             string
+		requestID        *common.Hash
 		logBlockHash     common.Hash
 		receiptBlockHash common.Hash
+		receiptLogs      []eth.Log
 		wantStatus       models.RunStatus
 	}{
 		{
 			name:             "main chain",
+			requestID:        &requestID,
 			logBlockHash:     triggeringBlockHash,
 			receiptBlockHash: triggeringBlockHash,
 			wantStatus:       models.RunStatusCompleted,
 		},
 		{
 			name:             "ommered chain",
+			requestID:        &requestID,
 			logBlockHash:     triggeringBlockHash,
 			receiptBlockHash: otherBlockHash,
-			wantStatus:       models.RunStatusErrored,
+			wantStatus:       models.RunStatusCancelled,
+		},
+		{
+			name:             "request mined again in another block",
+			requestID:        &requestID,
+			logBlockHash:     triggeringBlockHash,
+			receiptBlockHash: otherBlockHash,
+			receiptLogs:      []eth.Log{{Topics: []common.Hash{cltest.NewHash(), requestID}}},
+			wantStatus:       models.RunStatusCompleted,
+		},
+		{
+			name:             "run log mined again in another block",
+			requestID:        &runLogRequestID,
+			logBlockHash:     triggeringBlockHash,
+			receiptBlockHash: otherBlockHash,
+			receiptLogs:      []eth.Log{runLog},
+			wantStatus:       models.RunStatusCompleted,
+		},
+		{
+			name:             "other request mined in another block",
+			requestID:        &requestID,
+			logBlockHash:     triggeringBlockHash,
+			receiptBlockHash: otherBlockHash,
+			receiptLogs:      []eth.Log{runLog},
+			wantStatus:       models.RunStatusCancelled,
+		},
+		{
+			name:             "request without ID mined again in another block",
+			logBlockHash:     triggeringBlockHash,
+			receiptBlockHash: otherBlockHash,
+			wantStatus:       models.RunStatusCompleted,
 		},
 	}
 
@@ -350,10 +484,9 @@ func TestRunManager_Create_fromRunLog_Happy(t *testing.T) {
 			require.NoError(t, app.Store.CreateJob(&job))
 
 			creationHeight := big.NewInt(1)
-			requestID := common.HexToHash("0xcafe")
 			initiator := job.Initiators[0]
 			rr := models.NewRunRequest(models.JSON{})
-			rr.RequestID = &requestID
+			rr.RequestID = test.requestID
 			rr.TxHash = &initiatingTxHash
 			rr.BlockHash = &test.logBlockHash
 			rr.RequestParams = cltest.JSONFromString(t, `{"random": "input"}`)
@@ -368,12 +501,13 @@ func TestRunManager_Create_fromRunLog_Happy(t *testing.T) {
 				Hash:        initiatingTxHash,
 				BlockHash:   &test.receiptBlockHash,
 				BlockNumber: cltest.Int(3),
+				Logs:        test.receiptLogs,
 			}
 			app.EthMock.Context("validateOnMainChain", func(meth *cltest.EthMock) {
 				meth.Register("eth_getTransactionReceipt", confirmedReceipt)
 			})
 
-			err = app.RunManager.ResumeAllPendingNextBlock(big.NewInt(2))
+			err = app.RunManager.ResumeAllPendingNextBlock(nil, big.NewInt(2))
 			require.NoError(t, err)
 			run = cltest.WaitForJobRunStatus(t, app.Store, *jr, test.wantStatus)
 			assert.Equal(t, rr.RequestID, run.RunRequest.RequestID)
@@ -663,7 +797,7 @@ func TestRunManager_Create_fromRunLog_ConnectToLaggingEthNode(t *testing.T) {
 	require.NoError(t, err)
 	cltest.WaitForJobRunToPendIncomingConfirmations(t, app.Store, *jr)
 
-	err = app.RunManager.ResumeAllPendingNextBlock(pastCurrentHeight)
+	err = app.RunManager.ResumeAllPendingNextBlock(nil, pastCurrentHeight)
 	require.NoError(t, err)
 
 	updatedJR := cltest.WaitForJobRunToPendIncomingConfirmations(t, app.Store, *jr)
@@ -702,7 +836,7 @@ func TestRunManager_ResumeConfirmingTasks(t *testing.T) {
 			runQueue.On("Run", mock.Anything).Return(nil)
 
 			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
-			runManager.ResumeAllPendingNextBlock(big.NewInt(3821))
+			runManager.ResumeAllPendingNextBlock(nil, big.NewInt(3821))
 
 			runQueue.AssertExpectations(t)
 		})
@@ -911,3 +1045,26 @@ func TestRunManager_NewRun(t *testing.T) {
 		assert.Len(t, adapters, 1)
 	})
 }
+
+func TestNewRun_SkipsTasksComputingFluxMonitorAnswer(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	job.Initiators[0].Feeds = models.Feeds{}
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "httpget", `{"get": "https://example.com/price"}`),
+		cltest.NewTask(t, "ethint256"),
+		cltest.NewTask(t, "ethtx"),
+	}
+	request := models.NewRunRequest(cltest.JSONFromString(t, `{"result": "123"}`))
+
+	run, _ := services.NewRun(&job, &job.Initiators[0], nil, request, store.Config, store.ORM, time.Now())
+	require.Len(t, run.TaskRuns, 3)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[0].Status)
+	assert.Equal(t, "123", run.TaskRuns[0].Result.Data.Get("result").String())
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[1].Status)
+	index, runnable := run.NextTaskRunIndex()
+	assert.True(t, runnable)
+	assert.Equal(t, 1, index)
+}
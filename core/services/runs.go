@@ -10,6 +10,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func markInProgressIfSufficientIncomingConfirmations(run *models.JobRun, taskRun *models.TaskRun, currentHeight *utils.Big, txManager store.TxManager) {
@@ -23,37 +25,40 @@ func markInProgressIfSufficientIncomingConfirmations(run *models.JobRun, taskRun
 		taskRun.Status = models.RunStatusPendingIncomingConfirmations
 		run.SetStatus(models.RunStatusPendingIncomingConfirmations)
 
-	} else if err := validateOnMainChain(run, taskRun, txManager); err != nil {
-		logger.Warnw("Failure while trying to validate chain",
-			run.ForLogger("error", err)...,
-		)
-
-		taskRun.SetError(err)
-		run.SetError(err)
-
-	} else {
+	} else if validateOnMainChain(run, taskRun, txManager) {
 		run.SetStatus(models.RunStatusInProgress)
 	}
 }
 
-func validateOnMainChain(run *models.JobRun, taskRun *models.TaskRun, txManager store.TxManager) error {
+// validateOnMainChain checks that the log initiating the run is still on the
+// main chain, and returns true if so. Runs whose initiating log was reorged
+// out are cancelled; runs whose log could not be checked are left pending
+// incoming confirmations, to be checked again on the next head.
+func validateOnMainChain(run *models.JobRun, taskRun *models.TaskRun, txManager store.TxManager) bool {
 	txhash := run.RunRequest.TxHash
 	if txhash == nil || !taskRun.MinRequiredIncomingConfirmations.Valid || taskRun.MinRequiredIncomingConfirmations.Uint32 == 0 {
-		return nil
+		return true
 	}
 
 	receipt, err := txManager.GetTxReceipt(*txhash)
 	if err != nil {
-		return err
+		logger.Warnw("Failure while trying to validate chain, will retry on next head",
+			run.ForLogger("error", err)...,
+		)
+		taskRun.Status = models.RunStatusPendingIncomingConfirmations
+		run.SetStatus(models.RunStatusPendingIncomingConfirmations)
+		return false
 	}
 	if invalidRequest(run.RunRequest, receipt) {
-		return fmt.Errorf(
-			"TxHash %s initiating run %s not on main chain; presumably has been uncled",
+		logger.Warnw(fmt.Sprintf(
+			"TxHash %s initiating run %s not on main chain; presumably has been uncled. Cancelling run",
 			txhash.Hex(),
 			run.ID.String(),
-		)
+		), run.ForLogger()...)
+		run.Cancel()
+		return false
 	}
-	return nil
+	return true
 }
 
 func updateTaskRunObservedIncomingConfirmations(currentHeight *utils.Big, jr *models.JobRun, taskRun *models.TaskRun) {
@@ -69,9 +74,34 @@ func updateTaskRunObservedIncomingConfirmations(currentHeight *utils.Big, jr *mo
 	taskRun.ObservedIncomingConfirmations = clnull.Uint32From(uint32(diff.Int64()))
 }
 
+// invalidRequest returns true if the transaction that emitted the request is
+// no longer mined, or was mined again in another block without emitting the
+// request. Requests without an ID, such as those of ethlog initiators, remain
+// valid as long as their transaction is mined.
 func invalidRequest(request models.RunRequest, receipt *eth.TxReceipt) bool {
-	return receipt.Unconfirmed() ||
-		(request.BlockHash != nil && *request.BlockHash != *receipt.BlockHash)
+	if receipt.Unconfirmed() {
+		return true
+	}
+	if request.BlockHash == nil || request.RequestID == nil || *request.BlockHash == *receipt.BlockHash {
+		return false
+	}
+	return !receiptContainsRequest(*request.RequestID, receipt)
+}
+
+// receiptContainsRequest returns true if one of the receipt's logs is a
+// request log with the given ID, or has the ID as one of its topics.
+func receiptContainsRequest(requestID common.Hash, receipt *eth.TxReceipt) bool {
+	for _, log := range receipt.Logs {
+		if id, err := models.ParseRequestID(log); err == nil && id == requestID {
+			return true
+		}
+		for _, topic := range log.Topics {
+			if topic == requestID {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func meetsMinRequiredIncomingConfirmations(
@@ -30,13 +30,21 @@ type JobSubscription struct {
 }
 
 // StartJobSubscription constructs a JobSubscription which listens for and
-// tracks event logs corresponding to the specified job. Ignores any errors if
-// there is at least one successful subscription to an initiator log.
+// tracks event logs on the default chain corresponding to the specified job.
+// Ignores any errors if there is at least one successful subscription to an
+// initiator log.
 func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.Store, runManager RunManager) (JobSubscription, error) {
+	return StartChainJobSubscription(job, head, store, store.DefaultChain(), runManager)
+}
+
+// StartChainJobSubscription constructs a JobSubscription which listens for
+// and tracks event logs on the given chain corresponding to the specified
+// job.
+func StartChainJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.Store, chain strpkg.Chain, runManager RunManager) (JobSubscription, error) {
 	var merr error
 	var unsubscribers []Unsubscriber
 
-	initrs := job.InitiatorsFor(models.LogBasedChainlinkJobInitiators...)
+	initrs := chainLogInitiators(job, store, chain)
 
 	nextHead := head.NextInt() // Exclude current block from subscription
 	if replayFromBlock := store.Config.ReplayFromBlock(); replayFromBlock >= 0 {
@@ -44,8 +52,14 @@ func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.S
 		nextHead = replayFromBlockBN
 	}
 
+	callback := func(runManager RunManager, le models.LogRequest) {
+		if ReceiveLogRequest(runManager, le) {
+			recordLogConsumption(store, le)
+		}
+	}
+
 	for _, initr := range initrs {
-		unsubscriber, err := NewInitiatorSubscription(initr, store.TxManager, runManager, nextHead, ReceiveLogRequest)
+		unsubscriber, err := NewInitiatorSubscription(initr, chain.TxManager, runManager, nextHead, callback)
 		if err == nil {
 			unsubscribers = append(unsubscribers, unsubscriber)
 		} else {
@@ -61,6 +75,18 @@ func StartJobSubscription(job models.JobSpec, head *models.Head, store *strpkg.S
 	return JobSubscription{Job: job, unsubscribers: unsubscribers}, merr
 }
 
+// chainLogInitiators returns the log initiators of the job bound to the given
+// chain.
+func chainLogInitiators(job models.JobSpec, store *strpkg.Store, chain strpkg.Chain) []models.Initiator {
+	var initrs []models.Initiator
+	for _, initr := range job.InitiatorsFor(models.LogBasedChainlinkJobInitiators...) {
+		if onChain(store, initr, chain) {
+			initrs = append(initrs, initr)
+		}
+	}
+	return initrs
+}
+
 // Unsubscribe stops the subscription and cleans up associated resources.
 func (js JobSubscription) Unsubscribe() {
 	for _, sub := range js.unsubscribers {
@@ -126,49 +152,62 @@ func loggerLogListening(initr models.Initiator, blockNumber *big.Int) {
 }
 
 // ReceiveLogRequest parses the log and runs the job it indicated by its
-// GetJobSpecID method
-func ReceiveLogRequest(runManager RunManager, le models.LogRequest) {
+// GetJobSpecID method. It returns true if a run, possibly errored, was
+// created for the log.
+func ReceiveLogRequest(runManager RunManager, le models.LogRequest) bool {
 	if !le.Validate() {
 		logger.Debugw("discarding INVALID EVENT LOG", "log", le.GetLog())
-		return
+		return false
 	}
 
 	if le.GetLog().Removed {
 		logger.Debugw("Skipping run for removed log", "log", le.GetLog(), "jobId", le.GetJobSpecID().String())
-		return
+		return false
 	}
 
 	le.ToDebug()
 
-	runJob(runManager, le)
+	return runJob(runManager, le)
+}
 
+// recordLogConsumption marks the log as consumed by its job, so that
+// replaying the logs of its block range does not run the job again.
+func recordLogConsumption(store *strpkg.Store, le models.LogRequest) {
+	lc := models.NewLogConsumption(le.GetLog(), le.GetJobSpecID())
+	if err := store.CreateLogConsumption(&lc); err != nil {
+		logger.Debugw("Unable to record log consumption", le.ForLogger("error", err)...)
+	}
 }
 
-func runJob(runManager RunManager, le models.LogRequest) {
+func runJob(runManager RunManager, le models.LogRequest) bool {
 	jobSpecID := le.GetJobSpecID()
 	initiator := le.GetInitiator()
 
 	if err := le.ValidateRequester(); err != nil {
-		if _, e := runManager.CreateErrored(jobSpecID, initiator, err); e != nil {
+		_, e := runManager.CreateErrored(jobSpecID, initiator, err)
+		if e != nil {
 			logger.Errorw(e.Error())
 		}
 		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		return e == nil
 	}
 
 	rr, err := le.RunRequest()
 	if err != nil {
-		if _, e := runManager.CreateErrored(jobSpecID, initiator, err); e != nil {
+		_, e := runManager.CreateErrored(jobSpecID, initiator, err)
+		if e != nil {
 			logger.Errorw(e.Error())
 		}
 		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		return e == nil
 	}
 
 	_, err = runManager.Create(jobSpecID, &initiator, le.BlockNumber(), &rr)
 	if err != nil {
 		logger.Errorw(err.Error(), le.ForLogger()...)
+		return false
 	}
+	return true
 }
 
 // ManagedSubscription encapsulates the connecting, backfilling, and clean up of an
@@ -9,12 +9,14 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/asaskevich/govalidator"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 )
@@ -94,6 +96,10 @@ func ValidateExternalInitiator(
 
 // ValidateInitiator checks the Initiator for any application logic errors.
 func ValidateInitiator(i models.Initiator, j models.JobSpec, store *store.Store) error {
+	if _, err := store.Chain(i.ChainID.ToInt()); err != nil {
+		return models.NewJSONAPIErrorsWith(err.Error())
+	}
+
 	switch strings.ToLower(i.Type) {
 	case models.InitiatorRunAt:
 		return validateRunAtInitiator(i, j)
@@ -147,8 +153,17 @@ func validateFluxMonitor(i models.Initiator, j models.JobSpec, store *store.Stor
 			"before a new report is made")
 	}
 
+	if i.AggregatorVersion != "" && !contracts.AggregatorVersion(i.AggregatorVersion).Supported() {
+		fe.Add(fmt.Sprintf("unsupported aggregatorVersion %s, must be one of %v",
+			i.AggregatorVersion, contracts.AggregatorVersions))
+	}
+
 	if i.PollTimer.Disabled && i.IdleTimer.Disabled {
-		fe.Add("must enable pollTimer, idleTimer, or both")
+		if !i.Drumbeat.Enabled() {
+			fe.Add("must enable pollTimer, idleTimer, drumbeat, or a combination of them")
+		} else if i.WatchOnly {
+			fe.Add("watchOnly never submits on a drumbeat, must enable pollTimer, idleTimer, or both")
+		}
 	}
 
 	if i.PollTimer.Disabled {
@@ -177,13 +192,57 @@ func validateFluxMonitor(i models.Initiator, j models.JobSpec, store *store.Stor
 		}
 	}
 
-	if err := validateFeeds(i.Feeds, store); err != nil {
+	if i.ComputesAnswerFromTasks() {
+		if err := validateAnswerTasks(i, j, store); err != nil {
+			fe.Add(err.Error())
+		}
+	} else if err := validateFeeds(i.Feeds, store); err != nil {
+		fe.Add(err.Error())
+	} else if err := validateQuorum(i); err != nil {
 		fe.Add(err.Error())
 	}
 
 	return fe.CoerceEmptyToNil()
 }
 
+// validateQuorum checks that the minimum number of responses can be met by
+// the feeds of a flux monitor initiator, and that its outlier threshold is
+// not negative.
+func validateQuorum(i models.Initiator) error {
+	feedCount := len(i.Feeds.Array())
+	if i.MinResponses < 0 {
+		return errors.New("minResponses cannot be negative")
+	} else if int(i.MinResponses) > feedCount {
+		return fmt.Errorf("minResponses %d exceeds the %d feeds", i.MinResponses, feedCount)
+	} else if i.OutlierThreshold < 0 {
+		return errors.New("outlierThreshold cannot be negative")
+	}
+	return nil
+}
+
+// validateAnswerTasks checks that the off-chain tasks of a flux monitor job
+// without feeds can compute its answers, which requires them to complete
+// immediately.
+func validateAnswerTasks(i models.Initiator, j models.JobSpec, store *store.Store) error {
+	tasks := adapters.OffChainTasks(j.Tasks)
+	if len(tasks) == 0 {
+		return errors.New("feeds field is empty, and no task precedes the ethereum tasks to compute the answer")
+	}
+	if i.Precision != 0 {
+		return errors.New("precision cannot be set without feeds, the job's tasks must scale the answer themselves")
+	}
+	for _, task := range tasks {
+		adapter, err := adapters.For(task, store.Config, store.ORM)
+		if err != nil {
+			return err
+		}
+		if _, ok := adapter.BaseAdapter.(*adapters.Bridge); ok {
+			return fmt.Errorf("bridge %s cannot compute the answer, list it in feeds instead", task.Type)
+		}
+	}
+	return nil
+}
+
 func validateFeeds(feeds models.Feeds, store *store.Store) error {
 	var feedsData []interface{}
 	if err := json.Unmarshal(feeds.Bytes(), &feedsData); err != nil {
@@ -200,13 +259,26 @@ func validateFeeds(feeds models.Feeds, store *store.Store) error {
 			if _, err := url.ParseRequestURI(feed); err != nil {
 				return err
 			}
-		case map[string]interface{}: // named feed - ex: {"bridge": "bridgeName"}
+		case map[string]interface{}: // named feed - ex: {"bridge": "bridgeName", "maxAge": "5m"}
+			if err := validateFeedMaxAge(feed); err != nil {
+				return err
+			}
+			if rawURL, ok := feed["url"]; ok {
+				if _, ok := feed["bridge"]; ok {
+					return errors.New("Feeds object cannot have both a bridge and a url")
+				}
+				urlString, ok := rawURL.(string)
+				if !ok {
+					return errors.New("Unsupported url type in feed JSON")
+				} else if _, err := url.ParseRequestURI(urlString); err != nil {
+					return err
+				}
+				continue
+			}
 			bridgeName := feed["bridge"]
 			bridgeNameString, ok := bridgeName.(string)
 			if bridgeName == nil {
 				return errors.New("Feeds object missing bridge key")
-			} else if len(feed) != 1 {
-				return errors.New("Unsupported keys in feed JSON")
 			} else if !ok {
 				return errors.New("Unsupported bridge name type in feed JSON")
 			}
@@ -222,6 +294,31 @@ func validateFeeds(feeds models.Feeds, store *store.Store) error {
 	return nil
 }
 
+// validateFeedMaxAge checks that a feed object only has supported keys, and
+// that its maxAge, if any, is a positive duration.
+func validateFeedMaxAge(feed map[string]interface{}) error {
+	for key := range feed {
+		if key != "bridge" && key != "url" && key != "maxAge" {
+			return errors.New("Unsupported keys in feed JSON")
+		}
+	}
+	rawMaxAge, ok := feed["maxAge"]
+	if !ok {
+		return nil
+	}
+	maxAgeString, ok := rawMaxAge.(string)
+	if !ok {
+		return errors.New("Unsupported maxAge type in feed JSON")
+	}
+	maxAge, err := time.ParseDuration(maxAgeString)
+	if err != nil {
+		return errors.Wrap(err, "invalid maxAge in feed JSON")
+	} else if maxAge <= 0 {
+		return errors.New("maxAge in feed JSON must be positive")
+	}
+	return nil
+}
+
 func validateRunLogInitiator(i models.Initiator, j models.JobSpec) error {
 	fe := models.NewJSONAPIErrors()
 	ethTxCount := 0
@@ -296,6 +393,25 @@ func validateTask(task models.TaskSpec, store *store.Store) error {
 	if err != nil {
 		return err
 	}
+	switch ba := adapter.BaseAdapter.(type) {
+	case *adapters.EthTx:
+		if _, err := store.Chain(ba.ChainID.ToInt()); err != nil {
+			return err
+		}
+		if err := validateFromAddress(ba.FromAddress, store); err != nil {
+			return err
+		}
+		if err := validateGasEstimator(ba, store); err != nil {
+			return err
+		}
+	case *adapters.EthTxABIEncode:
+		if _, err := store.Chain(ba.ChainID.ToInt()); err != nil {
+			return err
+		}
+		if err := validateFromAddress(ba.FromAddress, store); err != nil {
+			return err
+		}
+	}
 	if !store.Config.EnableExperimentalAdapters() {
 		if _, ok := adapter.BaseAdapter.(*adapters.Sleep); ok {
 			return errors.New("Sleep Adapter is not implemented yet")
@@ -307,6 +423,44 @@ func validateTask(task models.TaskSpec, store *store.Store) error {
 	return nil
 }
 
+// validateGasEstimator checks that the gas estimator named by the task can
+// price transactions on its chain.
+func validateGasEstimator(ethTx *adapters.EthTx, store *store.Store) error {
+	if ethTx.GasEstimator == "" {
+		return nil
+	}
+	return store.ValidateGasEstimator(ethTx.ChainID.ToInt(), ethTx.GasEstimator)
+}
+
+// validateFromAddress checks that the key a task is bound to belongs to the
+// node and may send transactions.
+func validateFromAddress(from *common.Address, store *store.Store) error {
+	if from == nil {
+		return nil
+	}
+	found := false
+	for _, account := range store.KeyStore.Accounts() {
+		if account.Address == *from {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("fromAddress %s is not a key of this node", from.Hex())
+	}
+
+	key, err := store.FindKey(*from)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "validating fromAddress")
+	}
+	if !key.CanSend() || key.Retiring {
+		return fmt.Errorf("fromAddress %s cannot send transactions", from.Hex())
+	}
+	return nil
+}
+
 // ValidateServiceAgreement checks the ServiceAgreement for any application logic errors.
 func ValidateServiceAgreement(sa models.ServiceAgreement, store *store.Store) error {
 	fe := models.NewJSONAPIErrors()
@@ -357,3 +511,23 @@ func ValidateServiceAgreement(sa models.ServiceAgreement, store *store.Store) er
 
 	return fe.CoerceEmptyToNil()
 }
+
+// ValidateChain checks that a chain can be served by the node.
+func ValidateChain(chain models.Chain, store *store.Store) error {
+	fe := models.NewJSONAPIErrors()
+	if chain.ID == nil || chain.ID.ToInt().Sign() <= 0 {
+		fe.Add("chain ID must be a positive integer")
+	} else if chain.ID.ToInt().Cmp(store.Config.ChainID()) == 0 {
+		fe.Add(fmt.Sprintf("chain %s is the default chain, configured by ETH_CHAIN_ID", chain.ID))
+	} else if _, err := store.FindChain(chain.ID.ToInt()); err == nil {
+		fe.Add(fmt.Sprintf("chain %s already exists", chain.ID))
+	} else if err != orm.ErrorNotFound {
+		return errors.Wrap(err, "validating chain")
+	}
+
+	u, err := url.ParseRequestURI(chain.EthURL)
+	if err != nil || (u.Scheme != "ws" && u.Scheme != "wss") {
+		fe.Add("ethUrl must be a websocket URL")
+	}
+	return fe.CoerceEmptyToNil()
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -404,6 +405,58 @@ func TestValidateInitiator_FluxMonitorHappy(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestValidateInitiator_FluxMonitorDrumbeat(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJob()
+	drumbeatOnly := cltest.MustJSONSet(t, validInitiator, "params.drumbeat.schedule", "CRON_TZ=UTC 0 * * * *")
+	drumbeatOnly = cltest.MustJSONSet(t, drumbeatOnly, "params.pollTimer", map[string]interface{}{"disabled": true})
+	drumbeatOnly = cltest.MustJSONSet(t, drumbeatOnly, "params.idleTimer", map[string]interface{}{"disabled": true})
+
+	var initr models.Initiator
+	require.NoError(t, json.Unmarshal([]byte(drumbeatOnly), &initr))
+	assert.Equal(t, models.Cron("CRON_TZ=UTC 0 * * * *"), initr.Drumbeat.Schedule)
+	assert.NoError(t, services.ValidateInitiator(initr, job, store))
+
+	noTimers := cltest.MustJSONDel(t, drumbeatOnly, "params.drumbeat")
+	initr = models.Initiator{}
+	require.NoError(t, json.Unmarshal([]byte(noTimers), &initr))
+	err := services.ValidateInitiator(initr, job, store)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must enable pollTimer, idleTimer, drumbeat")
+
+	watchOnly := cltest.MustJSONSet(t, drumbeatOnly, "params.watchOnly", true)
+	initr = models.Initiator{}
+	require.NoError(t, json.Unmarshal([]byte(watchOnly), &initr))
+	err = services.ValidateInitiator(initr, job, store)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "watchOnly never submits on a drumbeat")
+
+	noTimeZone := cltest.MustJSONSet(t, validInitiator, "params.drumbeat.schedule", "0 * * * *")
+	assert.Error(t, json.Unmarshal([]byte(noTimeZone), &models.Initiator{}))
+}
+
+func TestValidateInitiator_FluxMonitorWatchOnly(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJob()
+	watchOnly := cltest.MustJSONSet(t, validInitiator, "params.watchOnly", true)
+	// A watchdog never submits, so it ignores the parameters which only drive
+	// submissions.
+	watchOnly = cltest.MustJSONSet(t, watchOnly, "params.drumbeat.schedule", "CRON_TZ=UTC 0 * * * *")
+
+	var initr models.Initiator
+	require.NoError(t, json.Unmarshal([]byte(watchOnly), &initr))
+	require.True(t, initr.WatchOnly)
+	assert.NoError(t, services.ValidateInitiator(initr, job, store))
+}
+
 func TestValidateInitiator_FluxMonitorErrors(t *testing.T) {
 	t.Parallel()
 
@@ -423,6 +476,7 @@ func TestValidateInitiator_FluxMonitorErrors(t *testing.T) {
 		{"pollTimer enabled, but no period specified", cltest.MustJSONDel(t, validInitiator, "params.pollTimer.period")},
 		{"period must be equal or greater than 15s", cltest.MustJSONSet(t, validInitiator, "params.pollTimer.period", "1s")},
 		{"idleTimer.duration must be >= than pollTimer.period", cltest.MustJSONSet(t, validInitiator, "params.idleTimer.duration", "30s")},
+		{"aggregatorVersion", cltest.MustJSONSet(t, validInitiator, "params.aggregatorVersion", "AccessControlledAggregator")},
 	}
 	for _, test := range tests {
 		t.Run("bad "+test.Field, func(t *testing.T) {
@@ -435,6 +489,57 @@ func TestValidateInitiator_FluxMonitorErrors(t *testing.T) {
 	}
 }
 
+func TestValidateInitiator_FluxMonitorQuorum(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJob()
+	tests := []struct {
+		Field   string
+		JSONStr string
+	}{
+		{"minResponses cannot be negative", cltest.MustJSONSet(t, validInitiator, "params.minResponses", -1)},
+		{"minResponses 4 exceeds the 3 feeds", cltest.MustJSONSet(t, validInitiator, "params.minResponses", 4)},
+		{"outlierThreshold cannot be negative", cltest.MustJSONSet(t, validInitiator, "params.outlierThreshold", -0.5)},
+	}
+	for _, test := range tests {
+		t.Run(test.Field, func(t *testing.T) {
+			var initr models.Initiator
+			require.NoError(t, json.Unmarshal([]byte(test.JSONStr), &initr))
+			err := services.ValidateInitiator(initr, job, store)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.Field)
+		})
+	}
+}
+
+func TestValidateInitiator_FluxMonitorAnswerTasks(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	withoutFeeds := cltest.MustJSONDel(t, cltest.MustJSONDel(t, validInitiator, "params.feeds"), "params.precision")
+	var initr models.Initiator
+	require.NoError(t, json.Unmarshal([]byte(withoutFeeds), &initr))
+
+	job := cltest.NewJob()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "httpget", `{"get": "https://example.com/price"}`),
+		cltest.NewTask(t, "jsonparse", `{"path": ["last"]}`),
+		cltest.NewTask(t, "ethint256"),
+		cltest.NewTask(t, "ethtx"),
+	}
+	assert.NoError(t, services.ValidateInitiator(initr, job, store))
+
+	job.Tasks = job.Tasks[2:]
+	err := services.ValidateInitiator(initr, job, store)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no task precedes the ethereum tasks")
+}
+
 func TestValidateInitiator_FluxMonitor_EthereumDisabled(t *testing.T) {
 	t.Parallel()
 
@@ -469,6 +574,12 @@ func TestValidateInitiator_FeedsHappy(t *testing.T) {
 	initr.Feeds = cltest.JSONFromString(t, `["https://lambda.staging.devnet.tools/bnc/call", {"bridge": "testbridge"}]`)
 	err := services.ValidateInitiator(initr, job, store)
 	require.NoError(t, err)
+
+	initr.Feeds = cltest.JSONFromString(t, `[{"url": "https://lambda.staging.devnet.tools/bnc/call", "maxAge": "5m"}, {"bridge": "testbridge", "maxAge": "1h"}]`)
+	initr.MinResponses = 2
+	initr.OutlierThreshold = 3
+	err = services.ValidateInitiator(initr, job, store)
+	require.NoError(t, err)
 }
 
 func TestValidateInitiator_FeedsErrors(t *testing.T) {
@@ -497,6 +608,10 @@ func TestValidateInitiator_FeedsErrors(t *testing.T) {
 		{"missing bridge", `[{"bridgeName": "doesnotexist"}]`},
 		{"unsupported bridge properties", `[{"bridge": "testbridge", "foo": "bar"}]`},
 		{"invalid entry", `["http://example.com", {"bridge": "testbridge"}, 1]`},
+		{"bridge and url", `[{"bridge": "testbridge", "url": "http://example.com"}]`},
+		{"invalid url object", `[{"url": "invalid/url"}]`},
+		{"invalid maxAge", `[{"bridge": "testbridge", "maxAge": "soon"}]`},
+		{"negative maxAge", `[{"url": "http://example.com", "maxAge": "-1m"}]`},
 	}
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
@@ -508,3 +623,63 @@ func TestValidateInitiator_FeedsErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateJob_FromAddress(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, store.SyncDiskKeyStoreToDB())
+	address := cltest.GetAccountAddress(t, store)
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{
+		Type:   adapters.TaskTypeEthTx,
+		Params: cltest.JSONFromString(t, `{"address": %q, "fromAddress": %q}`, cltest.NewAddress().Hex(), address.Hex()),
+	}}
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	_, err := store.UpdateKey(address, models.KeyUsageReceiveOnly, false)
+	require.NoError(t, err)
+	assert.Error(t, services.ValidateJob(job, store))
+
+	job.Tasks[0].Params = cltest.JSONFromString(t, `{"address": %q, "fromAddress": %q}`, cltest.NewAddress().Hex(), cltest.NewAddress().Hex())
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_GasEstimator(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	tests := []struct {
+		name      string
+		estimator string
+		wantError bool
+	}{
+		{"block history", strpkg.GasEstimatorBlockHistory, false},
+		{"fee history", strpkg.GasEstimatorFeeHistory, false},
+		{"gas oracle without url", strpkg.GasEstimatorGasOracle, true},
+		{"unknown", "bogus", true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			job := cltest.NewJobWithWebInitiator()
+			job.Tasks = []models.TaskSpec{{
+				Type:   adapters.TaskTypeEthTx,
+				Params: cltest.JSONFromString(t, `{"address": %q, "gasEstimator": %q}`, cltest.NewAddress().Hex(), test.estimator),
+			}}
+
+			err := services.ValidateJob(job, store)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Empty(t, store.GasEstimators(nil))
+		})
+	}
+}
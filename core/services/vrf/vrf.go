@@ -341,3 +341,15 @@ func GenerateProof(secretKey, seed common.Hash) (*Proof, error) {
 		}
 	}
 }
+
+// offChainSeedHashPrefix is a domain-separation tag for the seeds of proofs
+// requested off-chain. On-chain seeds hash the 128-byte ABI encoding of their
+// request, so no off-chain seed can be the seed of an on-chain request.
+var offChainSeedHashPrefix = utils.MustHash("Chainlink off-chain VRF seed").Bytes()
+
+// OffChainSeed returns the seed proven for the given seed of an off-chain
+// request, which can not be used to fulfil an on-chain request.
+func OffChainSeed(seed *big.Int) *big.Int {
+	return utils.MustHash(string(append(offChainSeedHashPrefix,
+		common.BigToHash(seed).Bytes()...))).Big()
+}
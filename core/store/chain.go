@@ -0,0 +1,90 @@
+package store
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/pkg/errors"
+)
+
+// Chain holds the configuration and transaction manager of one of the chains
+// served by the node.
+type Chain struct {
+	ID        *big.Int
+	Config    orm.ConfigReader
+	TxManager TxManager
+}
+
+// IsDefault returns true if this is the chain configured by ETH_CHAIN_ID.
+func (c Chain) IsDefault(config orm.ConfigReader) bool {
+	return c.ID.Cmp(config.ChainID()) == 0
+}
+
+// DefaultChain returns the chain configured by ETH_CHAIN_ID and ETH_URL.
+func (s *Store) DefaultChain() Chain {
+	return Chain{
+		ID:        s.Config.ChainID(),
+		Config:    s.Config,
+		TxManager: s.TxManager,
+	}
+}
+
+// Chain returns the chain with the given ID, or the default chain if the ID
+// is nil.
+func (s *Store) Chain(id *big.Int) (Chain, error) {
+	if id == nil || id.Cmp(s.Config.ChainID()) == 0 {
+		return s.DefaultChain(), nil
+	}
+	for _, chain := range s.chains {
+		if chain.ID.Cmp(id) == 0 {
+			return chain, nil
+		}
+	}
+	return Chain{}, fmt.Errorf("chain %s is not served by this node", id)
+}
+
+// Chains returns every chain served by the node, starting with the default
+// chain.
+func (s *Store) Chains() []Chain {
+	return append([]Chain{s.DefaultChain()}, s.chains...)
+}
+
+// dialChains connects to the enabled additional chains saved in the
+// database. Chains added or removed afterwards take effect on restart.
+func dialChains(config *orm.Config, dialer Dialer, keyStore KeyStoreInterface, db *orm.ORM) ([]Chain, error) {
+	records, err := db.Chains()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load chains")
+	}
+
+	var chains []Chain
+	for _, record := range records {
+		if !record.Enabled {
+			continue
+		}
+		chain, err := newChain(config, dialer, keyStore, db, record)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to dial chain %s", record.ID)
+		}
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+func newChain(config *orm.Config, dialer Dialer, keyStore KeyStoreInterface, db *orm.ORM, record models.Chain) (Chain, error) {
+	chainConfig := orm.NewChainConfig(config, record)
+	ethrpc, err := dialer.Dial(chainConfig.EthereumURL())
+	if err != nil {
+		return Chain{}, err
+	}
+	client := &eth.CallerSubscriberClient{CallerSubscriber: ethrpc}
+	return Chain{
+		ID:        chainConfig.ChainID(),
+		Config:    chainConfig,
+		TxManager: NewEthTxManager(client, chainConfig, keyStore, db),
+	}, nil
+}
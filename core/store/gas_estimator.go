@@ -0,0 +1,408 @@
+package store
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tidwall/gjson"
+)
+
+// The gas estimators that can be selected with GAS_ESTIMATOR, per chain, or
+// with the gasEstimator parameter of an EthTx task.
+const (
+	// GasEstimatorFixed always returns ETH_GAS_PRICE_DEFAULT
+	GasEstimatorFixed = "fixed"
+	// GasEstimatorBlockHistory returns the GAS_UPDATER_TRANSACTION_PERCENTILE
+	// gas price of the transactions in the last GAS_UPDATER_BLOCK_HISTORY_SIZE
+	// blocks
+	GasEstimatorBlockHistory = "blockhistory"
+	// GasEstimatorGasOracle returns the gas price quoted by the HTTP gas oracle
+	// at GAS_ORACLE_URL
+	GasEstimatorGasOracle = "gasoracle"
+	// GasEstimatorFeeHistory returns the next block's base fee plus the
+	// GAS_UPDATER_TRANSACTION_PERCENTILE priority fee reported by
+	// eth_feeHistory over the last GAS_UPDATER_BLOCK_HISTORY_SIZE blocks
+	GasEstimatorFeeHistory = "feehistory"
+)
+
+var (
+	promGasEstimatorGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_estimator_gas_price",
+		Help: "Gas price returned by the gas estimator (in Wei)",
+	},
+		[]string{"chain_id", "estimator"},
+	)
+
+	promGasUpdaterAllPercentiles = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_all_gas_percetiles",
+		Help: "Gas price at given percentile",
+	},
+		[]string{"percentile"},
+	)
+
+	promGasUpdaterSetGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_set_gas_price",
+		Help: "Gas updater set gas price (in Wei)",
+	},
+		[]string{"percentile", "block_num"},
+	)
+)
+
+// GasEstimator picks the gas price of outgoing transactions. Estimators
+// learning from past blocks rely on being sent every new head.
+type GasEstimator interface {
+	HeadTrackable
+	Name() string
+	GasPrice() (*big.Int, error)
+}
+
+// GasEstimatorName returns the name of the gas estimator configured for a
+// chain.
+func GasEstimatorName(config orm.ConfigReader) string {
+	if name := config.GasEstimator(); name != "" {
+		return name
+	}
+	if config.GasUpdaterEnabled() {
+		return GasEstimatorBlockHistory
+	}
+	return GasEstimatorFixed
+}
+
+// GasEstimator returns the named gas estimator of the given chain, or the
+// estimator configured for the chain if name is empty. Estimators are created
+// on first use and shared from then on.
+func (s *Store) GasEstimator(chainID *big.Int, name string) (GasEstimator, error) {
+	chain, err := s.Chain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = GasEstimatorName(chain.Config)
+	}
+
+	key := fmt.Sprintf("%s/%s", chain.ID, name)
+	s.gasEstimatorsMutex.Lock()
+	defer s.gasEstimatorsMutex.Unlock()
+	if estimator, ok := s.gasEstimators[key]; ok {
+		return estimator, nil
+	}
+	estimator, err := newGasEstimator(s, chain.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	s.gasEstimators[key] = estimator
+	return estimator, nil
+}
+
+// GasEstimators returns the gas estimators of the given chain created so far,
+// which are those its gas updater sends new heads to.
+func (s *Store) GasEstimators(chainID *big.Int) []GasEstimator {
+	chain, err := s.Chain(chainID)
+	if err != nil {
+		return nil
+	}
+
+	prefix := chain.ID.String() + "/"
+	s.gasEstimatorsMutex.Lock()
+	defer s.gasEstimatorsMutex.Unlock()
+	var estimators []GasEstimator
+	for key, estimator := range s.gasEstimators {
+		if strings.HasPrefix(key, prefix) {
+			estimators = append(estimators, estimator)
+		}
+	}
+	return estimators
+}
+
+// ValidateGasEstimator returns an error if the named gas estimator does not
+// exist, or is not configured to price transactions on the given chain.
+func (s *Store) ValidateGasEstimator(chainID *big.Int, name string) error {
+	chain, err := s.Chain(chainID)
+	if err != nil {
+		return err
+	}
+	switch name {
+	case GasEstimatorFixed, GasEstimatorBlockHistory, GasEstimatorFeeHistory:
+		return nil
+	case GasEstimatorGasOracle:
+		if chain.Config.GasOracleURL() == nil {
+			return fmt.Errorf("gas estimator %q needs GAS_ORACLE_URL to be set for chain %s", name, chain.ID)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown gas estimator %q", name)
+	}
+}
+
+func newGasEstimator(store *Store, chainID *big.Int, name string) (GasEstimator, error) {
+	base := gasEstimatorBase{store: store, chainID: chainID}
+	switch name {
+	case GasEstimatorFixed:
+		return &fixedGasEstimator{base}, nil
+	case GasEstimatorBlockHistory:
+		return &BlockHistoryEstimator{gasEstimatorBase: base}, nil
+	case GasEstimatorGasOracle:
+		return &gasOracleEstimator{base}, nil
+	case GasEstimatorFeeHistory:
+		return &feeHistoryEstimator{base}, nil
+	default:
+		return nil, fmt.Errorf("unknown gas estimator %q", name)
+	}
+}
+
+// gasEstimatorBase resolves the estimator's chain on every use, so that the
+// default chain always reflects the store's current TxManager.
+type gasEstimatorBase struct {
+	store   *Store
+	chainID *big.Int
+}
+
+func (b gasEstimatorBase) chain() (Chain, error) {
+	return b.store.Chain(b.chainID)
+}
+
+// Connect does nothing; estimators need no setup.
+func (b gasEstimatorBase) Connect(*models.Head) error { return nil }
+
+// Disconnect does nothing.
+func (b gasEstimatorBase) Disconnect() {}
+
+// OnNewHead does nothing; only the block history estimator tracks heads.
+func (b gasEstimatorBase) OnNewHead(*models.Head) {}
+
+// clampGasPrice bounds the estimated gas price to the chain's
+// [ETH_MIN_GAS_PRICE_WEI, ETH_MAX_GAS_PRICE_WEI] range and reports it.
+func clampGasPrice(chain Chain, name string, gasPrice *big.Int) *big.Int {
+	if min := chain.Config.EthMinGasPriceWei(); gasPrice.Cmp(min) < 0 {
+		logger.Debugw("Gas estimate is below EthMinGasPriceWei, using the minimum instead", "estimator", name, "gasPrice", gasPrice, "min", min, "chainID", chain.ID)
+		gasPrice = min
+	}
+	if max := chain.Config.EthMaxGasPriceWei(); gasPrice.Cmp(max) > 0 {
+		logger.Warnw("Gas estimate exceeds EthMaxGasPriceWei, using the maximum instead", "estimator", name, "gasPrice", gasPrice, "max", max, "chainID", chain.ID)
+		gasPrice = max
+	}
+	f, _ := new(big.Float).SetInt(gasPrice).Float64()
+	promGasEstimatorGasPrice.WithLabelValues(chain.ID.String(), name).Set(f)
+	return new(big.Int).Set(gasPrice)
+}
+
+type fixedGasEstimator struct {
+	gasEstimatorBase
+}
+
+func (e *fixedGasEstimator) Name() string {
+	return GasEstimatorFixed
+}
+
+func (e *fixedGasEstimator) GasPrice() (*big.Int, error) {
+	chain, err := e.chain()
+	if err != nil {
+		return nil, err
+	}
+	return clampGasPrice(chain, e.Name(), chain.Config.EthGasPriceDefault()), nil
+}
+
+// BlockHistoryEstimator estimates the gas price from the prices paid by the
+// transactions of recent blocks.
+type BlockHistoryEstimator struct {
+	gasEstimatorBase
+	mutex               sync.Mutex
+	rollingBlockHistory []eth.Block
+	gasPrice            *big.Int
+}
+
+// Name returns GasEstimatorBlockHistory.
+func (e *BlockHistoryEstimator) Name() string {
+	return GasEstimatorBlockHistory
+}
+
+// OnNewHead adds the block GAS_UPDATER_BLOCK_DELAY blocks behind head to the
+// history, and recalculates the gas price once the history is full.
+func (e *BlockHistoryEstimator) OnNewHead(head *models.Head) {
+	chain, err := e.chain()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	// HACK: blockDelay is the number of blocks that the estimator trails
+	// behind head. This is necessary because geth/parity send heads as soon
+	// as they get them and often the actual block is not available until
+	// later. Fetching it too early results in an empty block.
+	blockDelay := int64(chain.Config.GasUpdaterBlockDelay())
+	blockToFetch := head.Number - blockDelay
+	if blockToFetch < 0 {
+		logger.Warnf("GasUpdater: skipping gas calculation, current block height %v is lower than GAS_UPDATER_BLOCK_DELAY of %v", head.Number, blockDelay)
+		return
+	}
+	block, err := chain.TxManager.GetBlockByNumber(utils.Uint64ToHex(uint64(blockToFetch)))
+	if err != nil {
+		logger.Error(err, fmt.Sprintf("GasUpdater: error retrieving block %v", blockToFetch))
+		return
+	}
+	logger.Debugw("GasUpdater: got block", "blockNumber", blockToFetch)
+	if len(block.Transactions) == 0 {
+		logger.Debugw("GasUpdater: skipping empty block", "blockNumber", blockToFetch)
+		return
+	}
+
+	historySize := int(chain.Config.GasUpdaterBlockHistorySize())
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.rollingBlockHistory = append(e.rollingBlockHistory, block)
+	if len(e.rollingBlockHistory) <= historySize {
+		logger.Debugw("GasUpdater: waiting for blocks", "inHistory", len(e.rollingBlockHistory), "required", historySize)
+		return
+	}
+	e.rollingBlockHistory = e.rollingBlockHistory[len(e.rollingBlockHistory)-historySize:]
+	percentile := int(chain.Config.GasUpdaterTransactionPercentile())
+	e.gasPrice = big.NewInt(e.percentileGasPrice(percentile))
+	promGasUpdaterSetGasPrice.WithLabelValues(fmt.Sprintf("%v%%", percentile), fmt.Sprint(blockToFetch)).Set(float64(e.gasPrice.Int64()))
+}
+
+func (e *BlockHistoryEstimator) percentileGasPrice(percentile int) int64 {
+	gasPrices := make([]int64, 0)
+	for _, block := range e.rollingBlockHistory {
+		for _, tx := range block.Transactions {
+			gasPrices = append(gasPrices, int64(tx.GasPrice))
+		}
+	}
+	sort.Slice(gasPrices, func(i, j int) bool { return gasPrices[i] < gasPrices[j] })
+	idx := ((len(gasPrices) - 1) * percentile) / 100
+	for i := 0; i <= 100; i += 5 {
+		jdx := ((len(gasPrices) - 1) * i) / 100
+		promGasUpdaterAllPercentiles.WithLabelValues(fmt.Sprintf("%v%%", i)).Set(float64(gasPrices[jdx]))
+	}
+	return gasPrices[idx]
+}
+
+// GasPrice returns the configured percentile of the gas prices in the block
+// history, or an error until the history is full.
+func (e *BlockHistoryEstimator) GasPrice() (*big.Int, error) {
+	chain, err := e.chain()
+	if err != nil {
+		return nil, err
+	}
+	e.mutex.Lock()
+	gasPrice := e.gasPrice
+	e.mutex.Unlock()
+	if gasPrice == nil {
+		return nil, errors.New("not enough blocks in history to estimate the gas price")
+	}
+	return clampGasPrice(chain, e.Name(), gasPrice), nil
+}
+
+// RollingBlockHistory returns the blocks the gas price is calculated from.
+func (e *BlockHistoryEstimator) RollingBlockHistory() []eth.Block {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return append([]eth.Block{}, e.rollingBlockHistory...)
+}
+
+type gasOracleEstimator struct {
+	gasEstimatorBase
+}
+
+func (e *gasOracleEstimator) Name() string {
+	return GasEstimatorGasOracle
+}
+
+func (e *gasOracleEstimator) GasPrice() (*big.Int, error) {
+	chain, err := e.chain()
+	if err != nil {
+		return nil, err
+	}
+	oracleURL := chain.Config.GasOracleURL()
+	if oracleURL == nil {
+		return nil, errors.New("GAS_ORACLE_URL is not set")
+	}
+
+	client := &http.Client{Timeout: chain.Config.DefaultHTTPTimeout().Duration()}
+	resp, err := client.Get(oracleURL.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "while querying the gas oracle")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("gas oracle responded with status %d", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, chain.Config.DefaultHTTPLimit()))
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading the gas oracle response")
+	}
+
+	path := chain.Config.GasOraclePath()
+	result := gjson.GetBytes(body, path)
+	if !result.Exists() {
+		return nil, fmt.Errorf("gas oracle response has no value at path %q", path)
+	}
+	gasPrice, _ := new(big.Float).Mul(
+		big.NewFloat(result.Float()),
+		big.NewFloat(chain.Config.GasOracleMultiplier()),
+	).Int(nil)
+	return clampGasPrice(chain, e.Name(), gasPrice), nil
+}
+
+type feeHistoryEstimator struct {
+	gasEstimatorBase
+}
+
+type feeHistory struct {
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+}
+
+func (e *feeHistoryEstimator) Name() string {
+	return GasEstimatorFeeHistory
+}
+
+func (e *feeHistoryEstimator) GasPrice() (*big.Int, error) {
+	chain, err := e.chain()
+	if err != nil {
+		return nil, err
+	}
+
+	var history feeHistory
+	err = chain.TxManager.Call(&history, "eth_feeHistory",
+		hexutil.Uint64(chain.Config.GasUpdaterBlockHistorySize()),
+		"latest",
+		[]float64{float64(chain.Config.GasUpdaterTransactionPercentile())},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching fee history")
+	}
+	if len(history.BaseFeePerGas) == 0 {
+		return nil, errors.New("ethereum node returned no fee history")
+	}
+
+	// The last base fee is that of the next block
+	gasPrice := new(big.Int).Set(history.BaseFeePerGas[len(history.BaseFeePerGas)-1].ToInt())
+	tips := big.NewInt(0)
+	count := int64(0)
+	for _, rewards := range history.Reward {
+		if len(rewards) > 0 && rewards[0] != nil {
+			tips.Add(tips, rewards[0].ToInt())
+			count++
+		}
+	}
+	if count > 0 {
+		gasPrice.Add(gasPrice, tips.Div(tips, big.NewInt(count)))
+	}
+	return clampGasPrice(chain, e.Name(), gasPrice), nil
+}
@@ -0,0 +1,131 @@
+package store_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_GasEstimator(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	estimator, err := store.GasEstimator(nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.GasEstimatorFixed, estimator.Name())
+
+	config.Set("GAS_UPDATER_ENABLED", "true")
+	estimator, err = store.GasEstimator(nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.GasEstimatorBlockHistory, estimator.Name())
+
+	again, err := store.GasEstimator(nil, strpkg.GasEstimatorBlockHistory)
+	require.NoError(t, err)
+	assert.True(t, estimator == again)
+
+	_, err = store.GasEstimator(nil, "crystalball")
+	assert.Error(t, err)
+	_, err = store.GasEstimator(big.NewInt(424242), strpkg.GasEstimatorFixed)
+	assert.Error(t, err)
+}
+
+func TestGasEstimator_Fixed_ClampsGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("ETH_GAS_PRICE_DEFAULT", 10)
+	config.Set("ETH_MIN_GAS_PRICE_WEI", 50)
+	config.Set("ETH_MAX_GAS_PRICE_WEI", 100)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	estimator, err := store.GasEstimator(nil, strpkg.GasEstimatorFixed)
+	require.NoError(t, err)
+
+	gasPrice, err := estimator.GasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(50), gasPrice)
+
+	config.Set("ETH_GAS_PRICE_DEFAULT", 1000)
+	gasPrice, err = estimator.GasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), gasPrice)
+}
+
+func TestGasEstimator_GasOracle(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"fast": 45.5, "slow": 20}}`)
+	}))
+	defer server.Close()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("GAS_ORACLE_URL", server.URL)
+	config.Set("GAS_ORACLE_PATH", "data.fast")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	estimator, err := store.GasEstimator(nil, strpkg.GasEstimatorGasOracle)
+	require.NoError(t, err)
+
+	gasPrice, err := estimator.GasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(45500000000), gasPrice)
+
+	config.Set("GAS_ORACLE_PATH", "data.instant")
+	_, err = estimator.GasPrice()
+	assert.Error(t, err)
+}
+
+func TestGasEstimator_FeeHistory(t *testing.T) {
+	t.Parallel()
+
+	config, cfgCleanup := cltest.NewConfig(t)
+	defer cfgCleanup()
+	config.Set("GAS_UPDATER_BLOCK_HISTORY_SIZE", "2")
+	config.Set("GAS_UPDATER_TRANSACTION_PERCENTILE", "50")
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	txm := new(mocks.TxManager)
+	txm.On("Call", mock.Anything, "eth_feeHistory", hexutil.Uint64(2), "latest", []float64{50}).
+		Run(func(args mock.Arguments) {
+			history := args.Get(0)
+			err := json.Unmarshal([]byte(`{
+				"baseFeePerGas": ["0x64", "0x6e", "0x78"],
+				"reward": [["0xa"], ["0x1e"]]
+			}`), history)
+			require.NoError(t, err)
+		}).
+		Return(nil)
+	store.TxManager = txm
+
+	estimator, err := store.GasEstimator(nil, strpkg.GasEstimatorFeeHistory)
+	require.NoError(t, err)
+
+	gasPrice, err := estimator.GasPrice()
+	require.NoError(t, err)
+	// Next block's base fee of 120 plus the average tip of 20
+	assert.Equal(t, big.NewInt(140), gasPrice)
+
+	txm.AssertExpectations(t)
+}
@@ -0,0 +1,254 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ErrKeyHasUnconfirmedTxs is returned when deleting or disabling a key whose
+// transactions are still pending. Rotate the key instead to drain them.
+var ErrKeyHasUnconfirmedTxs = errors.New("key has unconfirmed transactions, rotate it to retire it once they are confirmed")
+
+// ErrRemoteSignerKeys is returned when managing the keys of a remote signer,
+// which are only known to the signer and have to be managed there.
+var ErrRemoteSignerKeys = errors.New("keys held by a remote signer have to be managed by the signer")
+
+// ImportKey adds the encrypted JSON key to the key store, encrypting it with
+// the node password instead of its own password.
+func (s *Store) ImportKey(keyJSON []byte, keyPassword, nodePassword string) (*models.Key, error) {
+	if err := s.EnsureLocalKeys(); err != nil {
+		return nil, err
+	}
+	if err := s.KeyStore.Unlock(nodePassword); err != nil {
+		return nil, err
+	}
+	account, err := s.KeyStore.Import(keyJSON, keyPassword, nodePassword)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to import key")
+	}
+	if err := s.KeyStore.Unlock(nodePassword); err != nil {
+		return nil, err
+	}
+	if err := s.SyncDiskKeyStoreToDB(); err != nil {
+		return nil, err
+	}
+	s.registerAccounts(s.KeyStore.Accounts())
+	return s.FindKey(account.Address)
+}
+
+// ExportKey returns the JSON key of address, encrypted with exportPassword.
+func (s *Store) ExportKey(address common.Address, nodePassword, exportPassword string) ([]byte, error) {
+	if err := s.EnsureLocalKeys(); err != nil {
+		return nil, err
+	}
+	if _, err := s.FindKey(address); err != nil {
+		return nil, err
+	}
+	return s.KeyStore.Export(accounts.Account{Address: address}, nodePassword, exportPassword)
+}
+
+// RemoveKey deletes the key of address from the key store and the database.
+// Keys with unconfirmed transactions cannot be removed.
+func (s *Store) RemoveKey(address common.Address, nodePassword string) error {
+	if err := s.EnsureLocalKeys(); err != nil {
+		return err
+	}
+	if _, err := s.FindKey(address); err != nil {
+		return err
+	}
+	if err := s.ensureNoUnconfirmedTxs(address); err != nil {
+		return err
+	}
+	if err := s.KeyStore.Delete(accounts.Account{Address: address}, nodePassword); err != nil {
+		return errors.Wrap(err, "unable to delete key")
+	}
+	if err := s.DeleteKey(address.Bytes()); err != nil {
+		return err
+	}
+	s.registerAccounts(s.KeyStore.Accounts())
+	return nil
+}
+
+// UpdateKey sets what the key of address is used for and whether it is
+// disabled. Keys with unconfirmed transactions cannot stop sending.
+func (s *Store) UpdateKey(address common.Address, usage models.KeyUsage, disabled bool) (*models.Key, error) {
+	if err := s.EnsureLocalKeys(); err != nil {
+		return nil, err
+	}
+	key, err := s.FindKey(address)
+	if err != nil {
+		return nil, err
+	}
+	couldSend := key.CanSend()
+	key.Usage = usage
+	key.Disabled = disabled
+	if disabled {
+		key.Retiring = false
+	}
+	if couldSend && !key.CanSend() {
+		if err := s.ensureNoUnconfirmedTxs(address); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.SaveKey(key); err != nil {
+		return nil, err
+	}
+	s.registerAccounts(s.KeyStore.Accounts())
+	return key, nil
+}
+
+// RotateKey creates a new key with the usage of the key of address, which
+// starts retiring: it sends no new transactions, and is disabled by
+// RetireDrainedKeys once its pending transactions are confirmed.
+func (s *Store) RotateKey(address common.Address, nodePassword string) (*models.Key, error) {
+	if err := s.EnsureLocalKeys(); err != nil {
+		return nil, err
+	}
+	old, err := s.FindKey(address)
+	if err != nil {
+		return nil, err
+	}
+	if old.Disabled || old.Retiring {
+		return nil, fmt.Errorf("key %s is already disabled or retiring", address.Hex())
+	}
+	if err := s.KeyStore.Unlock(nodePassword); err != nil {
+		return nil, err
+	}
+
+	account, err := s.KeyStore.NewAccount(nodePassword)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create key")
+	}
+	if err := s.SyncDiskKeyStoreToDB(); err != nil {
+		return nil, err
+	}
+	key, err := s.FindKey(account.Address)
+	if err != nil {
+		return nil, err
+	}
+	key.Usage = old.Usage
+	if err := s.SaveKey(key); err != nil {
+		return nil, err
+	}
+
+	old.Retiring = true
+	if err := s.SaveKey(old); err != nil {
+		return nil, err
+	}
+	logger.Infow(fmt.Sprintf("Rotating key %s to %s", address.Hex(), account.Address.Hex()), "from", address.Hex(), "to", account.Address.Hex())
+	s.registerAccounts(s.KeyStore.Accounts())
+	return key, nil
+}
+
+// RetireDrainedKeys disables the retiring keys whose transactions are all
+// confirmed.
+func (s *Store) RetireDrainedKeys() error {
+	keys, err := s.Keys()
+	if err != nil {
+		return err
+	}
+
+	retired := false
+	for _, key := range keys {
+		if !key.Retiring {
+			continue
+		}
+		pending, err := s.HasUnconfirmedTxs(key.Address.Address())
+		if err != nil {
+			return err
+		}
+		if pending {
+			continue
+		}
+		key.Retiring = false
+		key.Disabled = true
+		if err := s.SaveKey(key); err != nil {
+			return err
+		}
+		logger.Infow(fmt.Sprintf("Retired key %s", key.Address), "address", key.Address)
+		retired = true
+	}
+	if retired {
+		s.registerAccounts(s.KeyStore.Accounts())
+	}
+	return nil
+}
+
+// EnsureLocalKeys returns ErrRemoteSignerKeys if the keys of the node are
+// held by a remote signer.
+func (s *Store) EnsureLocalKeys() error {
+	if _, ok := s.KeyStore.(*RemoteKeyStore); ok {
+		return ErrRemoteSignerKeys
+	}
+	return nil
+}
+
+// ReceivingAccount returns the first account that may be given out to
+// receive funds, or nil if every key is disabled or send only. Accounts
+// without a key in the database, such as those of a remote signer, may
+// receive.
+func (s *Store) ReceivingAccount() (*accounts.Account, error) {
+	if _, err := s.KeyStore.GetFirstAccount(); err != nil {
+		return nil, err
+	}
+	keys, err := s.Keys()
+	if err != nil {
+		return nil, err
+	}
+	byAddress := make(map[common.Address]*models.Key, len(keys))
+	for _, key := range keys {
+		byAddress[key.Address.Address()] = key
+	}
+	for _, account := range s.KeyStore.Accounts() {
+		if key, ok := byAddress[account.Address]; !ok || key.CanReceive() {
+			return &account, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Store) ensureNoUnconfirmedTxs(address common.Address) error {
+	pending, err := s.HasUnconfirmedTxs(address)
+	if err != nil {
+		return err
+	} else if pending {
+		return ErrKeyHasUnconfirmedTxs
+	}
+	return nil
+}
+
+// registerAccounts registers the accounts that may send transactions with
+// the TxManager of every chain, retiring those being rotated out. Accounts
+// without a key in the database, such as those of a remote signer, may send.
+func (s *Store) registerAccounts(accts []accounts.Account) {
+	keys, err := s.Keys()
+	if err != nil {
+		logger.Errorw("Unable to load keys, registering all accounts", "error", err)
+	}
+	byAddress := make(map[common.Address]*models.Key, len(keys))
+	for _, key := range keys {
+		byAddress[key.Address.Address()] = key
+	}
+
+	var sending, retiring []accounts.Account
+	for _, account := range accts {
+		key, ok := byAddress[account.Address]
+		if ok && !key.CanSend() {
+			continue
+		}
+		sending = append(sending, account)
+		if ok && key.Retiring {
+			retiring = append(retiring, account)
+		}
+	}
+	for _, chain := range s.Chains() {
+		chain.TxManager.Register(sending)
+		chain.TxManager.Retire(retiring)
+	}
+}
@@ -0,0 +1,142 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_UpdateKey(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, store.SyncDiskKeyStoreToDB())
+	address := cltest.GetAccountAddress(t, store)
+
+	key, err := store.UpdateKey(address, models.KeyUsageSendOnly, false)
+	require.NoError(t, err)
+	assert.Equal(t, models.KeyUsageSendOnly, key.Usage)
+	assert.True(t, key.CanSend())
+
+	cltest.CreateTx(t, store, address, 1)
+	_, err = store.UpdateKey(address, models.KeyUsageReceiveOnly, false)
+	assert.Equal(t, strpkg.ErrKeyHasUnconfirmedTxs, err)
+	_, err = store.UpdateKey(address, models.KeyUsageSendOnly, true)
+	assert.Equal(t, strpkg.ErrKeyHasUnconfirmedTxs, err)
+	assert.Equal(t, strpkg.ErrKeyHasUnconfirmedTxs, store.RemoveKey(address, cltest.Password))
+
+	key, err = store.FindKey(address)
+	require.NoError(t, err)
+	assert.Equal(t, models.KeyUsageSendOnly, key.Usage)
+	assert.False(t, key.Disabled)
+}
+
+func TestStore_RotateKey(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, store.SyncDiskKeyStoreToDB())
+	address := cltest.GetAccountAddress(t, store)
+	tx := cltest.CreateTx(t, store, address, 1)
+
+	newKey, err := store.RotateKey(address, cltest.Password)
+	require.NoError(t, err)
+	assert.NotEqual(t, address, newKey.Address.Address())
+	assert.Len(t, store.KeyStore.Accounts(), 2)
+
+	_, err = store.RotateKey(address, cltest.Password)
+	assert.Error(t, err)
+
+	require.NoError(t, store.RetireDrainedKeys())
+	old, err := store.FindKey(address)
+	require.NoError(t, err)
+	assert.True(t, old.Retiring)
+	assert.False(t, old.Disabled)
+
+	tx.Confirmed = true
+	require.NoError(t, store.SaveTx(tx))
+	require.NoError(t, store.RetireDrainedKeys())
+	old, err = store.FindKey(address)
+	require.NoError(t, err)
+	assert.False(t, old.Retiring)
+	assert.True(t, old.Disabled)
+}
+
+func TestStore_ExportImportAndRemoveKey(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, store.SyncDiskKeyStoreToDB())
+	require.NoError(t, store.KeyStore.Unlock(cltest.Password))
+	address := cltest.GetAccountAddress(t, store)
+
+	_, err := store.ExportKey(address, "wrong password", "export password")
+	assert.Error(t, err)
+	keyJSON, err := store.ExportKey(address, cltest.Password, "export password")
+	require.NoError(t, err)
+
+	require.NoError(t, store.RemoveKey(address, cltest.Password))
+	assert.Len(t, store.KeyStore.Accounts(), 0)
+	_, err = store.FindKey(address)
+	assert.Error(t, err)
+
+	key, err := store.ImportKey(keyJSON, "export password", cltest.Password)
+	require.NoError(t, err)
+	assert.Equal(t, address, key.Address.Address())
+	assert.Len(t, store.KeyStore.Accounts(), 1)
+}
+
+func TestStore_ReceivingAccount(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, store.SyncDiskKeyStoreToDB())
+	address := cltest.GetAccountAddress(t, store)
+
+	account, err := store.ReceivingAccount()
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	assert.Equal(t, address, account.Address)
+
+	_, err = store.UpdateKey(address, models.KeyUsageSendOnly, false)
+	require.NoError(t, err)
+	account, err = store.ReceivingAccount()
+	require.NoError(t, err)
+	assert.Nil(t, account)
+}
+
+func TestStore_RemoteSignerKeys(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	signer, cleanup := cltest.NewRemoteSigner(t)
+	defer cleanup()
+	ks, err := strpkg.DialRemoteKeyStore(signer.URL)
+	require.NoError(t, err)
+	store.KeyStore = ks
+	address := signer.Addresses()[0]
+
+	assert.Equal(t, strpkg.ErrRemoteSignerKeys, store.EnsureLocalKeys())
+	_, err = store.UpdateKey(address, models.KeyUsageSendOnly, false)
+	assert.Equal(t, strpkg.ErrRemoteSignerKeys, err)
+	_, err = store.RotateKey(address, cltest.Password)
+	assert.Equal(t, strpkg.ErrRemoteSignerKeys, err)
+	_, err = store.ExportKey(address, cltest.Password, "export password")
+	assert.Equal(t, strpkg.ErrRemoteSignerKeys, err)
+	assert.Equal(t, strpkg.ErrRemoteSignerKeys, store.RemoveKey(address, cltest.Password))
+
+	account, err := store.ReceivingAccount()
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	assert.Equal(t, address, account.Address)
+}
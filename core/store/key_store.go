@@ -31,6 +31,8 @@ type KeyStoreInterface interface {
 	NewAccount(passphrase string) (accounts.Account, error)
 	SignHash(hash common.Hash) (models.Signature, error)
 	Import(keyJSON []byte, passphrase, newPassphrase string) (accounts.Account, error)
+	Export(a accounts.Account, passphrase, newPassphrase string) ([]byte, error)
+	Delete(a accounts.Account, passphrase string) error
 	GetAccounts() []accounts.Account
 
 	SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
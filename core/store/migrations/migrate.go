@@ -52,6 +52,22 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1588757164"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1588853064"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1589470036"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1590226486"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1591141873"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1591603775"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1591862416"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592144386"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592228942"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592319416"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592402154"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592487640"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592576218"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592662848"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592749248"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592835648"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1592922048"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1593008448"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1593094848"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -257,6 +273,70 @@ func init() {
 			ID:      "1586871710",
 			Migrate: migration1586871710.Migrate,
 		},
+		{
+			ID:      "1590226486",
+			Migrate: migration1590226486.Migrate,
+		},
+		{
+			ID:      "1591141873",
+			Migrate: migration1591141873.Migrate,
+		},
+		{
+			ID:      "1591603775",
+			Migrate: migration1591603775.Migrate,
+		},
+		{
+			ID:      "1591862416",
+			Migrate: migration1591862416.Migrate,
+		},
+		{
+			ID:      "1592144386",
+			Migrate: migration1592144386.Migrate,
+		},
+		{
+			ID:      "1592228942",
+			Migrate: migration1592228942.Migrate,
+		},
+		{
+			ID:      "1592319416",
+			Migrate: migration1592319416.Migrate,
+		},
+		{
+			ID:      "1592402154",
+			Migrate: migration1592402154.Migrate,
+		},
+		{
+			ID:      "1592487640",
+			Migrate: migration1592487640.Migrate,
+		},
+		{
+			ID:      "1592576218",
+			Migrate: migration1592576218.Migrate,
+		},
+		{
+			ID:      "1592662848",
+			Migrate: migration1592662848.Migrate,
+		},
+		{
+			ID:      "1592749248",
+			Migrate: migration1592749248.Migrate,
+		},
+		{
+			ID:      "1592835648",
+			Migrate: migration1592835648.Migrate,
+		},
+		{
+			ID:      "1592922048",
+			Migrate: migration1592922048.Migrate,
+		},
+		{
+			ID:      "1593008448",
+			Migrate: migration1593008448.Migrate,
+		},
+		{
+			ID:      "1593094848",
+			Migrate: migration1593094848.Migrate,
+		},
 	}
 }
 
@@ -0,0 +1,31 @@
+package migration1590226486
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the chains table and scopes heads, txes and initiators to a
+// chain ID.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	CREATE TABLE chains (
+		id numeric(78,0) PRIMARY KEY,
+		eth_url text NOT NULL,
+		config jsonb NOT NULL DEFAULT '{}',
+		enabled boolean NOT NULL DEFAULT true,
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL
+	);
+
+	ALTER TABLE heads ADD COLUMN chain_id numeric(78,0);
+	CREATE INDEX idx_heads_chain_id_number ON heads(chain_id, number);
+
+	-- rows with a NULL chain_id predate multi-chain support and are
+	-- assigned to the default chain when the node boots
+	ALTER TABLE txes ADD COLUMN chain_id numeric(78,0);
+	DROP INDEX idx_txes_unique_nonces_per_account;
+	CREATE UNIQUE INDEX idx_txes_unique_nonces_per_chain_account ON txes(chain_id, nonce, "from");
+
+	ALTER TABLE initiators ADD COLUMN chain_id numeric(78,0);
+	`).Error
+}
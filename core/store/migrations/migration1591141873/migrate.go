@@ -0,0 +1,14 @@
+package migration1591141873
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the parent hash of each head, so that the head tracker can
+// detect reorganisations of the chain.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE heads ADD COLUMN parent_hash bytea NOT NULL DEFAULT decode(repeat('00', 32), 'hex');
+	CREATE INDEX idx_heads_chain_id_hash ON heads(chain_id, hash);
+	`).Error
+}
@@ -0,0 +1,28 @@
+package migration1591603775
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the lifecycle of ethereum keys: what a key may be used for,
+// whether it is disabled, and whether it is being rotated out.
+//
+// The columns may already exist, since migration0 auto migrates models.Key.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE keys ADD COLUMN IF NOT EXISTS usage varchar(32);
+	UPDATE keys SET usage = 'send_receive' WHERE usage IS NULL OR usage = '';
+	ALTER TABLE keys ALTER COLUMN usage SET DEFAULT 'send_receive';
+	ALTER TABLE keys ALTER COLUMN usage SET NOT NULL;
+
+	ALTER TABLE keys ADD COLUMN IF NOT EXISTS disabled boolean;
+	UPDATE keys SET disabled = false WHERE disabled IS NULL;
+	ALTER TABLE keys ALTER COLUMN disabled SET DEFAULT false;
+	ALTER TABLE keys ALTER COLUMN disabled SET NOT NULL;
+
+	ALTER TABLE keys ADD COLUMN IF NOT EXISTS retiring boolean;
+	UPDATE keys SET retiring = false WHERE retiring IS NULL;
+	ALTER TABLE keys ALTER COLUMN retiring SET DEFAULT false;
+	ALTER TABLE keys ALTER COLUMN retiring SET NOT NULL;
+	`).Error
+}
@@ -0,0 +1,14 @@
+package migration1591862416
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate tracks the cancellation of transactions sent by cancelled runs, and
+// which attempts replace them with a no-op.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE txes ADD COLUMN cancellation varchar(16) NOT NULL DEFAULT '';
+	ALTER TABLE tx_attempts ADD COLUMN cancellation boolean NOT NULL DEFAULT false;
+	`).Error
+}
@@ -0,0 +1,14 @@
+package migration1592144386
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the quorum and outlier filtering settings of flux monitor
+// initiators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE initiators ADD COLUMN min_responses integer NOT NULL DEFAULT 0;
+	ALTER TABLE initiators ADD COLUMN outlier_threshold float NOT NULL DEFAULT 0;
+	`).Error
+}
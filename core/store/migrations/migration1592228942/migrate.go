@@ -0,0 +1,28 @@
+package migration1592228942
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate creates the history of the decisions and submissions of flux
+// monitor jobs.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	CREATE TABLE flux_monitor_rounds (
+		id BIGSERIAL PRIMARY KEY,
+		job_spec_id uuid NOT NULL,
+		round_id bigint NOT NULL,
+		trigger varchar(32) NOT NULL,
+		answer numeric,
+		latest_answer numeric,
+		deviation numeric,
+		eligible boolean NOT NULL,
+		submitted boolean NOT NULL,
+		skip_reason text NOT NULL,
+		job_run_id uuid,
+		payment varchar(255),
+		created_at timestamptz NOT NULL
+	);
+	CREATE INDEX idx_flux_monitor_rounds_job_spec_id_created_at ON flux_monitor_rounds (job_spec_id, created_at);
+	`).Error
+}
@@ -0,0 +1,13 @@
+package migration1592319416
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate records the estimated cost in LINK of the submissions considered
+// by flux monitor jobs.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE flux_monitor_rounds ADD COLUMN submission_cost varchar(255);
+	`).Error
+}
@@ -0,0 +1,12 @@
+package migration1592402154
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate lets flux monitor initiators be paused.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE initiators ADD COLUMN paused boolean NOT NULL DEFAULT false;
+	`).Error
+}
@@ -0,0 +1,14 @@
+package migration1592487640
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate records the version of the aggregator contract driven by flux
+// monitor initiators. Existing initiators drive FluxAggregators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE initiators ADD COLUMN aggregator_version varchar(255) NOT NULL DEFAULT '';
+	UPDATE initiators SET aggregator_version = 'FluxAggregator' WHERE type = 'fluxmonitor';
+	`).Error
+}
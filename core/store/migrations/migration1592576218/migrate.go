@@ -0,0 +1,13 @@
+package migration1592576218
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate lets flux monitor initiators watch an aggregator without submitting
+// to it.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE initiators ADD COLUMN watch_only boolean NOT NULL DEFAULT false;
+	`).Error
+}
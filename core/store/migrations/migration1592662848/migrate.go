@@ -0,0 +1,12 @@
+package migration1592662848
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the drumbeat schedule of flux monitor initiators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE initiators ADD COLUMN drumbeat jsonb;
+	`).Error
+}
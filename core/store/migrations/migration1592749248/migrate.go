@@ -0,0 +1,24 @@
+package migration1592749248
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the calls of job runs bundled into a transaction sent through
+// the multicall forwarder.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	CREATE TABLE tx_batch_calls (
+		id BIGSERIAL PRIMARY KEY,
+		job_run_id uuid NOT NULL REFERENCES job_runs(id) ON DELETE CASCADE,
+		tx_id bigint REFERENCES txes(id) ON DELETE CASCADE,
+		call_index integer NOT NULL,
+		error text,
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL
+	);
+
+	CREATE UNIQUE INDEX idx_tx_batch_calls_job_run_id ON tx_batch_calls(job_run_id);
+	CREATE INDEX idx_tx_batch_calls_tx_id ON tx_batch_calls(tx_id);
+	`).Error
+}
@@ -0,0 +1,29 @@
+package migration1592835648
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the log replays run in the background, with the counts of the
+// logs they processed, and indexes the log consumptions by creation time so
+// that the old ones can be pruned.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	CREATE TABLE log_replays (
+		id BIGSERIAL PRIMARY KEY,
+		from_block bigint NOT NULL,
+		to_block bigint NOT NULL,
+		job_id uuid REFERENCES job_specs(id) ON DELETE CASCADE,
+		chain_id numeric(78,0),
+		status text NOT NULL,
+		logs_found integer NOT NULL DEFAULT 0,
+		logs_replayed integer NOT NULL DEFAULT 0,
+		logs_skipped integer NOT NULL DEFAULT 0,
+		error text,
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL
+	);
+
+	CREATE INDEX idx_log_consumptions_created_at ON log_consumptions(created_at);
+	`).Error
+}
@@ -0,0 +1,13 @@
+package migration1592922048
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate indexes the flux monitor rounds by creation time, so that the old
+// ones can be pruned.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	CREATE INDEX idx_flux_monitor_rounds_created_at ON flux_monitor_rounds (created_at);
+	`).Error
+}
@@ -0,0 +1,16 @@
+package migration1593008448
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate requires every head to belong to a chain. Heads saved without a
+// chain ID predate multi-chain support and only hold the recent history of the
+// head tracker, which it fetches again when it connects, so they are dropped
+// rather than guessed to belong to the default chain.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	DELETE FROM heads WHERE chain_id IS NULL;
+	ALTER TABLE heads ALTER COLUMN chain_id SET NOT NULL;
+	`).Error
+}
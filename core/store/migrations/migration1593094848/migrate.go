@@ -0,0 +1,12 @@
+package migration1593094848
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Migrate adds the reason a transaction could not be cancelled.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+	ALTER TABLE txes ADD COLUMN cancellation_error text;
+	`).Error
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	null "gopkg.in/guregu/null.v3"
+)
+
+// Chain is an additional EVM chain served by this node, alongside the
+// default chain configured by ETH_CHAIN_ID and ETH_URL.
+type Chain struct {
+	ID        *utils.Big `json:"id" gorm:"primary_key;type:numeric(78,0)"`
+	EthURL    string     `json:"ethUrl" gorm:"not null"`
+	Config    ChainCfg   `json:"config" gorm:"type:jsonb"`
+	Enabled   bool       `json:"enabled" gorm:"not null"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// NewChain returns an enabled chain with the given ID and websocket URL.
+func NewChain(id *big.Int, ethURL string) Chain {
+	return Chain{
+		ID:      utils.NewBig(id),
+		EthURL:  ethURL,
+		Enabled: true,
+	}
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (c Chain) GetID() string {
+	return c.ID.String()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (c Chain) GetName() string {
+	return "chains"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (c *Chain) SetID(value string) error {
+	id, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return fmt.Errorf("invalid chain ID: %s", value)
+	}
+	c.ID = utils.NewBig(id)
+	return nil
+}
+
+// ChainCfg holds the per-chain overrides of the node's configuration. Any
+// field left unset falls back to the corresponding environment variable.
+type ChainCfg struct {
+	BlockBackfillDepth        null.Int        `json:"blockBackfillDepth"`
+	EthGasBumpThreshold       null.Int        `json:"ethGasBumpThreshold"`
+	EthGasBumpWei             *utils.Big      `json:"ethGasBumpWei,omitempty"`
+	EthGasLimitDefault        null.Int        `json:"ethGasLimitDefault"`
+	EthGasPriceDefault        *utils.Big      `json:"ethGasPriceDefault,omitempty"`
+	EthMaxGasPriceWei         *utils.Big      `json:"ethMaxGasPriceWei,omitempty"`
+	EthMinGasPriceWei         *utils.Big      `json:"ethMinGasPriceWei,omitempty"`
+	FluxMonitorLinkEthFeed    *common.Address `json:"fluxMonitorLinkEthFeed,omitempty"`
+	GasEstimator              null.String     `json:"gasEstimator"`
+	GasUpdaterEnabled         null.Bool       `json:"gasUpdaterEnabled"`
+	LinkContractAddress       null.String     `json:"linkContractAddress"`
+	MinIncomingConfirmations  null.Int        `json:"minIncomingConfirmations"`
+	MinOutgoingConfirmations  null.Int        `json:"minOutgoingConfirmations"`
+	MulticallForwarderAddress *common.Address `json:"multicallForwarderAddress,omitempty"`
+	OracleContractAddress     *common.Address `json:"oracleContractAddress,omitempty"`
+}
+
+// Value is defined so that we can store ChainCfg as JSONB, because
+// of an error with GORM where it has trouble with nested structs as JSONB.
+// See https://github.com/jinzhu/gorm/issues/2704
+func (cfg ChainCfg) Value() (driver.Value, error) {
+	return json.Marshal(cfg)
+}
+
+// Scan is defined so that we can read ChainCfg as JSONB, because
+// of an error with GORM where it has trouble with nested structs as JSONB.
+// See https://github.com/jinzhu/gorm/issues/2704
+func (cfg *ChainCfg) Scan(value interface{}) error {
+	if value == nil {
+		*cfg = ChainCfg{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("invalid Scan Source")
+	}
+	return json.Unmarshal(b, cfg)
+}
@@ -544,6 +544,42 @@ type CreateKeyRequest struct {
 	CurrentPassword string `json:"current_password"`
 }
 
+// ImportKeyRequest represents a request to import an encrypted JSON ethereum
+// key, which is encrypted again with the current password.
+type ImportKeyRequest struct {
+	CurrentPassword string          `json:"current_password"`
+	KeyPassword     string          `json:"key_password"`
+	Key             json.RawMessage `json:"key"`
+}
+
+// ExportKeyRequest represents a request to export an ethereum key, encrypted
+// with a new password.
+type ExportKeyRequest struct {
+	Address         common.Address `json:"address"`
+	CurrentPassword string         `json:"current_password"`
+	NewPassword     string         `json:"new_password"`
+}
+
+// DeleteKeyRequest represents a request to delete an ethereum key.
+type DeleteKeyRequest struct {
+	Address         common.Address `json:"address"`
+	CurrentPassword string         `json:"current_password"`
+}
+
+// RotateKeyRequest represents a request to replace an ethereum key with a
+// new one.
+type RotateKeyRequest struct {
+	Address         common.Address `json:"address"`
+	CurrentPassword string         `json:"current_password"`
+}
+
+// UpdateKeyRequest represents a request to change what an ethereum key is
+// used for, or to disable it. Omitted fields are left unchanged.
+type UpdateKeyRequest struct {
+	Usage    *string `json:"usage,omitempty"`
+	Disabled *bool   `json:"disabled,omitempty"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
@@ -25,6 +25,7 @@ type Tx struct {
 
 	Attempts []*TxAttempt `json:"-"`
 
+	ChainID  *utils.Big     `gorm:"type:numeric(78,0)"`
 	From     common.Address `gorm:"index;not null"`
 	To       common.Address `gorm:"not null"`
 	Data     []byte         `gorm:"not null"`
@@ -40,7 +41,31 @@ type Tx struct {
 	SignedRawTx []byte      `gorm:"not null"`
 	CreatedAt   time.Time   `json:"-"`
 	UpdatedAt   time.Time   `json:"-"`
-}
+
+	// Cancellation tracks the replacement of the transaction by a no-op when
+	// the run that sent it is cancelled.
+	Cancellation TxCancellation `gorm:"not null"`
+	// CancellationError is why the cancellation failed before either the
+	// transaction or the no-op was confirmed.
+	CancellationError null.String
+}
+
+// TxCancellation is the state of the cancellation of a transaction.
+type TxCancellation string
+
+const (
+	// TxCancellationNone means the transaction was not cancelled
+	TxCancellationNone TxCancellation = ""
+	// TxCancellationPending means a no-op replacing the transaction was sent,
+	// and neither is confirmed yet
+	TxCancellationPending TxCancellation = "pending"
+	// TxCancellationSucceeded means the no-op was confirmed in place of the
+	// transaction
+	TxCancellationSucceeded TxCancellation = "succeeded"
+	// TxCancellationFailed means the transaction was confirmed before it
+	// could be replaced, or that the no-op could not be priced above it
+	TxCancellationFailed TxCancellation = "failed"
+)
 
 // String implements Stringer for Tx
 func (tx *Tx) String() string {
@@ -83,6 +108,9 @@ type TxAttempt struct {
 	SentAt      uint64      `gorm:"not null"`
 	SignedRawTx []byte      `gorm:"not null"`
 	UpdatedAt   time.Time   `json:"-"`
+	// Cancellation is true if the attempt is a no-op replacing the
+	// transaction of a cancelled run.
+	Cancellation bool `gorm:"not null"`
 }
 
 // String implements Stringer for TxAttempt
@@ -131,30 +159,33 @@ func HighestPricedTxAttemptPerTx(items []TxAttempt) []TxAttempt {
 	return highestPriced
 }
 
+// HeadHistoryDepth is the number of recent heads kept for each chain.
+const HeadHistoryDepth = 100
+
 // Head represents a BlockNumber, BlockHash.
 type Head struct {
-	ID     uint64      `gorm:"primary_key;auto_increment"`
-	Hash   common.Hash `gorm:"not null"`
-	Number int64       `gorm:"index;not null"`
+	ID         uint64      `gorm:"primary_key;auto_increment"`
+	ChainID    *utils.Big  `gorm:"type:numeric(78,0);not null"`
+	Hash       common.Hash `gorm:"not null"`
+	ParentHash common.Hash `gorm:"not null"`
+	Number     int64       `gorm:"index;not null"`
 }
 
-// AfterCreate is a gorm hook that trims heads after its creation
+// AfterCreate is a gorm hook that trims heads of the same chain after its
+// creation, keeping the last HeadHistoryDepth
 func (h Head) AfterCreate(scope *gorm.Scope) (err error) {
-	scope.DB().Exec(`
+	return scope.DB().Exec(`
 	DELETE FROM heads
-	WHERE id <= (
+	WHERE chain_id = ? AND id <= (
 	  SELECT id
 	  FROM (
 		SELECT id
 		FROM heads
+		WHERE chain_id = ?
 		ORDER BY id DESC
-		LIMIT 1 OFFSET 100
+		LIMIT 1 OFFSET ?
 	  ) foo
-	)`)
-	if err != nil {
-		return err
-	}
-	return nil
+	)`, h.ChainID, h.ChainID, HeadHistoryDepth).Error
 }
 
 // NewHead returns a Head instance with a BlockNumber and BlockHash.
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+)
+
+// FluxMonitorTrigger is what made the flux monitor of a job consider
+// submitting an answer.
+type FluxMonitorTrigger string
+
+const (
+	// FluxMonitorTriggerPoll is a tick of the poll timer
+	FluxMonitorTriggerPoll FluxMonitorTrigger = "poll"
+	// FluxMonitorTriggerIdle is the idle timer firing
+	FluxMonitorTriggerIdle FluxMonitorTrigger = "idle"
+	// FluxMonitorTriggerDrumbeat is a tick of the drumbeat schedule
+	FluxMonitorTriggerDrumbeat FluxMonitorTrigger = "drumbeat"
+	// FluxMonitorTriggerRoundTimeout is the timeout of the current round
+	FluxMonitorTriggerRoundTimeout FluxMonitorTrigger = "roundTimeout"
+	// FluxMonitorTriggerNewRound is a new round started by another oracle
+	FluxMonitorTriggerNewRound FluxMonitorTrigger = "newRound"
+)
+
+// FluxMonitorRound records the decision the flux monitor of a job took when
+// it considered submitting an answer, and the submission it made, if any.
+type FluxMonitorRound struct {
+	ID        int64              `json:"id" gorm:"primary_key;auto_increment"`
+	JobSpecID *ID                `json:"jobSpecId" gorm:"index;not null"`
+	RoundID   uint32             `json:"roundId" gorm:"not null"`
+	Trigger   FluxMonitorTrigger `json:"trigger" gorm:"not null"`
+	// Answer is the polled answer, unset if the feeds were not polled.
+	Answer       decimal.NullDecimal `json:"answer" gorm:"type:numeric"`
+	LatestAnswer decimal.NullDecimal `json:"latestAnswer" gorm:"type:numeric"`
+	// Deviation is the change of the polled answer from the latest answer, as
+	// a percentage of the latter.
+	Deviation  decimal.NullDecimal `json:"deviation" gorm:"type:numeric"`
+	Eligible   bool                `json:"eligible" gorm:"not null"`
+	Submitted  bool                `json:"submitted" gorm:"not null"`
+	SkipReason string              `json:"skipReason" gorm:"not null"`
+	JobRunID   *ID                 `json:"jobRunId"`
+	Payment    *assets.Link        `json:"payment" gorm:"type:varchar(255)"`
+	// SubmissionCost is the estimated gas cost of submitting the answer,
+	// unset unless the node is configured with a LINK/ETH feed.
+	SubmissionCost *assets.Link `json:"submissionCost" gorm:"type:varchar(255)"`
+	// TxHash is the hash of the transaction submitting the answer, looked up
+	// from the run.
+	TxHash    *common.Hash `json:"txHash" gorm:"-"`
+	CreatedAt time.Time    `json:"createdAt" gorm:"index"`
+}
+
+// NewFluxMonitorRound returns the record of a decision of the flux monitor
+// of the job, made for the round.
+func NewFluxMonitorRound(jobSpecID *ID, roundID uint32, trigger FluxMonitorTrigger) *FluxMonitorRound {
+	return &FluxMonitorRound{
+		JobSpecID: jobSpecID,
+		RoundID:   roundID,
+		Trigger:   trigger,
+	}
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (r FluxMonitorRound) GetID() string {
+	return strconv.FormatInt(r.ID, 10)
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (r FluxMonitorRound) GetName() string {
+	return "flux_rounds"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (r *FluxMonitorRound) SetID(value string) error {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	r.ID = id
+	return nil
+}
+
+// Submit records that the answer was submitted for the round by the run.
+func (r *FluxMonitorRound) Submit(run *JobRun) {
+	r.Submitted = true
+	if run != nil {
+		r.JobRunID = run.ID
+	}
+}
@@ -198,13 +198,19 @@ type Initiator struct {
 	Type            string    `json:"type" gorm:"index;not null"`
 	CreatedAt       time.Time `json:"createdAt" gorm:"index"`
 	InitiatorParams `json:"params,omitempty"`
-	DeletedAt       null.Time `json:"-" gorm:"index"`
-	UpdatedAt       time.Time `json:"-"`
+	// Paused stops a flux monitor initiator from polling and submitting
+	// answers, until resumed.
+	Paused    bool      `json:"paused" gorm:"not null"`
+	DeletedAt null.Time `json:"-" gorm:"index"`
+	UpdatedAt time.Time `json:"-"`
 }
 
 // InitiatorParams is a collection of the possible parameters that different
 // Initiators may require.
 type InitiatorParams struct {
+	// ChainID binds the initiator to one of the chains served by the node.
+	// When unset the initiator runs on the default chain.
+	ChainID    *utils.Big        `json:"chainId,omitempty" gorm:"type:numeric(78,0)"`
 	Schedule   Cron              `json:"schedule,omitempty"`
 	Time       AnyTime           `json:"time,omitempty"`
 	Ran        bool              `json:"ran,omitempty"`
@@ -226,6 +232,29 @@ type InitiatorParams struct {
 	AbsoluteThreshold float32         `json:"absoluteThreshold" gorm:"type:float;not null"`
 	PollTimer         PollTimerConfig `json:"pollTimer,omitempty" gorm:"type:jsonb"`
 	IdleTimer         IdleTimerConfig `json:"idleTimer,omitempty" gorm:"type:jsonb"`
+	Drumbeat          DrumbeatConfig  `json:"drumbeat,omitempty" gorm:"type:jsonb"`
+	// MinResponses is the number of feeds which must answer, and survive
+	// outlier filtering, for a polled answer to be used. When unset a
+	// majority of the feeds is required.
+	MinResponses int32 `json:"minResponses,omitempty" gorm:"not null"`
+	// OutlierThreshold drops the feed answers further from the median than
+	// this many median absolute deviations. Zero disables outlier filtering.
+	OutlierThreshold float32 `json:"outlierThreshold,omitempty" gorm:"type:float;not null"`
+	// AggregatorVersion names the aggregator contract at Address, one of the
+	// versions supported by the flux monitor. It is detected when the job is
+	// created, if unset.
+	AggregatorVersion string `json:"aggregatorVersion,omitempty" gorm:"not null"`
+	// WatchOnly makes a flux monitor initiator follow the rounds of an
+	// aggregator the node does not submit to, alerting when its answer goes
+	// stale or deviates from the feeds, without ever creating job runs.
+	WatchOnly bool `json:"watchOnly,omitempty" gorm:"not null"`
+}
+
+// ComputesAnswerFromTasks returns true for flux monitor initiators without
+// feeds, whose answers are computed by running the off-chain tasks of their
+// job instead.
+func (p InitiatorParams) ComputesAnswerFromTasks() bool {
+	return len(p.Feeds.Array()) == 0
 }
 
 type PollTimerConfig struct {
@@ -290,6 +319,39 @@ func (itc *IdleTimerConfig) Scan(value interface{}) error {
 	return json.Unmarshal(b, itc)
 }
 
+// DrumbeatConfig schedules flux monitor submissions at fixed times, whatever
+// the deviation of the answer.
+type DrumbeatConfig struct {
+	Schedule Cron `json:"schedule,omitempty"`
+}
+
+// Enabled returns whether a drumbeat is scheduled.
+func (dc DrumbeatConfig) Enabled() bool {
+	return dc.Schedule != ""
+}
+
+// Value stores DrumbeatConfig as JSONB, as for PollTimerConfig.
+func (dc DrumbeatConfig) Value() (driver.Value, error) {
+	b, err := json.Marshal(dc)
+	if err != nil {
+		return nil, err
+	}
+	return b, err
+}
+
+// Scan reads DrumbeatConfig as JSONB, as for PollTimerConfig.
+func (dc *DrumbeatConfig) Scan(value interface{}) error {
+	if value == nil {
+		*dc = DrumbeatConfig{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("invalid Scan Source")
+	}
+	return json.Unmarshal(b, dc)
+}
+
 // Topics handle the serialization of ethereum log topics to and from the data store.
 type Topics [][]common.Hash
 
@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"time"
 
@@ -20,8 +21,46 @@ import (
 type Key struct {
 	Address   EIP55Address `gorm:"primary_key;type:varchar(64)"`
 	JSON      JSON         `gorm:"type:text"`
-	CreatedAt time.Time    `json:"-"`
-	UpdatedAt time.Time    `json:"-"`
+	Usage     KeyUsage     `gorm:"default:'send_receive'"`
+	Disabled  bool
+	Retiring  bool
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// KeyUsage restricts what an ethereum key is used for.
+type KeyUsage string
+
+const (
+	// KeyUsageSendReceive keys send transactions and receive funds.
+	KeyUsageSendReceive = KeyUsage("send_receive")
+	// KeyUsageSendOnly keys send transactions but are not given out to
+	// receive funds.
+	KeyUsageSendOnly = KeyUsage("send_only")
+	// KeyUsageReceiveOnly keys receive funds but never send transactions.
+	KeyUsageReceiveOnly = KeyUsage("receive_only")
+)
+
+// ParseKeyUsage returns the KeyUsage named s.
+func ParseKeyUsage(s string) (KeyUsage, error) {
+	switch usage := KeyUsage(s); usage {
+	case KeyUsageSendReceive, KeyUsageSendOnly, KeyUsageReceiveOnly:
+		return usage, nil
+	default:
+		return "", fmt.Errorf("invalid key usage %q, expected one of %s, %s or %s",
+			s, KeyUsageSendReceive, KeyUsageSendOnly, KeyUsageReceiveOnly)
+	}
+}
+
+// CanSend returns true if transactions may be sent from the key. A retiring
+// key can still send, so that its pending transactions are confirmed.
+func (k *Key) CanSend() bool {
+	return !k.Disabled && k.Usage != KeyUsageReceiveOnly
+}
+
+// CanReceive returns true if the key may be given out to receive funds.
+func (k *Key) CanReceive() bool {
+	return !k.Disabled && k.Usage != KeyUsageSendOnly
 }
 
 type EncryptedSecretVRFKey = vrfkey.EncryptedSecretKey
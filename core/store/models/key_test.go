@@ -0,0 +1,66 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, usage := range []models.KeyUsage{models.KeyUsageSendReceive, models.KeyUsageSendOnly, models.KeyUsageReceiveOnly} {
+		parsed, err := models.ParseKeyUsage(string(usage))
+		require.NoError(t, err)
+		assert.Equal(t, usage, parsed)
+	}
+
+	_, err := models.ParseKeyUsage("sometimes")
+	assert.Error(t, err)
+}
+
+func TestKey_CanSend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		key  models.Key
+		want bool
+	}{
+		{"send and receive", models.Key{Usage: models.KeyUsageSendReceive}, true},
+		{"send only", models.Key{Usage: models.KeyUsageSendOnly}, true},
+		{"receive only", models.Key{Usage: models.KeyUsageReceiveOnly}, false},
+		{"retiring", models.Key{Usage: models.KeyUsageSendReceive, Retiring: true}, true},
+		{"disabled", models.Key{Usage: models.KeyUsageSendReceive, Disabled: true}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.key.CanSend())
+		})
+	}
+}
+
+func TestKey_CanReceive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		key  models.Key
+		want bool
+	}{
+		{"send and receive", models.Key{Usage: models.KeyUsageSendReceive}, true},
+		{"send only", models.Key{Usage: models.KeyUsageSendOnly}, false},
+		{"receive only", models.Key{Usage: models.KeyUsageReceiveOnly}, true},
+		{"disabled", models.Key{Usage: models.KeyUsageReceiveOnly, Disabled: true}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.key.CanReceive())
+		})
+	}
+}
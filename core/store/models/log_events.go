@@ -415,6 +415,16 @@ func parserFromLog(log eth.Log) (logRequestParser, error) {
 	return parser, nil
 }
 
+// ParseRequestID returns the request ID of a run, service agreement execution
+// or randomness request log.
+func ParseRequestID(log eth.Log) (common.Hash, error) {
+	parser, err := parserFromLog(log)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return parser.parseRequestID(log)
+}
+
 // ParseRunLog decodes the CBOR in the ABI of the log event.
 func ParseRunLog(log eth.Log) (JSON, error) {
 	parser, err := parserFromLog(log)
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// LogReplayRequest selects the block range, and optionally the job and the
+// chain, whose historical logs are scanned again for missed runs.
+type LogReplayRequest struct {
+	FromBlock *uint64    `json:"fromBlock"`
+	ToBlock   *uint64    `json:"toBlock"`
+	JobID     *ID        `json:"jobId,omitempty"`
+	ChainID   *utils.Big `json:"chainId,omitempty"`
+}
+
+// ValidateLogReplayRequest returns an error if the block range of the
+// request is missing or empty.
+func ValidateLogReplayRequest(request *LogReplayRequest) error {
+	if request.FromBlock == nil || request.ToBlock == nil {
+		return errors.New("fromBlock and toBlock are required")
+	}
+	if *request.ToBlock < *request.FromBlock {
+		return errors.New("toBlock must not be lower than fromBlock")
+	}
+	return nil
+}
+
+// LogReplayStatus is the progress of a log replay.
+type LogReplayStatus string
+
+const (
+	// LogReplayStatusInProgress is used for replays still scanning logs.
+	LogReplayStatusInProgress LogReplayStatus = "in_progress"
+	// LogReplayStatusCompleted is used for replays that processed every log.
+	LogReplayStatusCompleted LogReplayStatus = "completed"
+	// LogReplayStatusErrored is used for replays that failed to fetch or
+	// process some of the logs.
+	LogReplayStatusErrored LogReplayStatus = "errored"
+)
+
+// LogReplay is a replay of the logs of a block range, run in the background.
+// It counts the logs found, and how many of them were handed to the run
+// manager or skipped, as already consumed or rejected by the run manager.
+type LogReplay struct {
+	ID           int64           `json:"-" gorm:"primary_key"`
+	FromBlock    uint64          `json:"fromBlock"`
+	ToBlock      uint64          `json:"toBlock"`
+	JobID        *ID             `json:"jobId,omitempty"`
+	ChainID      *utils.Big      `json:"chainId,omitempty" gorm:"type:numeric"`
+	Status       LogReplayStatus `json:"status"`
+	LogsFound    int             `json:"logsFound"`
+	LogsReplayed int             `json:"logsReplayed"`
+	LogsSkipped  int             `json:"logsSkipped"`
+	Error        null.String     `json:"error"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+// NewLogReplay returns an in progress replay of the request, which must have
+// been validated.
+func NewLogReplay(request LogReplayRequest) LogReplay {
+	return LogReplay{
+		FromBlock: *request.FromBlock,
+		ToBlock:   *request.ToBlock,
+		JobID:     request.JobID,
+		ChainID:   request.ChainID,
+		Status:    LogReplayStatusInProgress,
+	}
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (lr LogReplay) GetID() string {
+	return strconv.FormatInt(lr.ID, 10)
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (lr LogReplay) GetName() string {
+	return "log_replays"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (lr *LogReplay) SetID(value string) error {
+	ID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	lr.ID = ID
+	return nil
+}
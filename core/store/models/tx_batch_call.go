@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v3"
+)
+
+// TxBatchCall links a job run to its call within a transaction batched
+// through the multicall forwarder. TxID is set once the batch is sent, or
+// Error if it could not be.
+type TxBatchCall struct {
+	ID        uint64 `gorm:"primary_key;auto_increment"`
+	JobRunID  *ID    `gorm:"not null"`
+	TxID      *uint64
+	CallIndex int `gorm:"not null"`
+	Error     null.String
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Sent returns true if the batch holding the call was sent.
+func (c TxBatchCall) Sent() bool {
+	return c.TxID != nil
+}
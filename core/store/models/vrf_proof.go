@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/smartcontractkit/chainlink/core/store/models/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/pkg/errors"
+)
+
+// VRFProofRequest selects the registered key, and the seed, of a VRF proof
+// requested off-chain.
+type VRFProofRequest struct {
+	PublicKey vrfkey.PublicKey `json:"publicKey"`
+	Seed      *utils.Big       `json:"seed"`
+}
+
+// ValidateVRFProofRequest returns an error if the request is missing its seed,
+// or if the seed does not fit in a uint256.
+func ValidateVRFProofRequest(request *VRFProofRequest) error {
+	if request.Seed == nil {
+		return errors.New("seed is required")
+	}
+	return errors.Wrap(utils.CheckUint256(request.Seed.ToInt()), "invalid seed")
+}
@@ -0,0 +1,524 @@
+package orm
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/contrib/sessions"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ChainConfig is the configuration of one of the additional chains served by
+// the node. It layers the chain's overrides on top of the node's Config,
+// so any setting the chain leaves unset reads the environment as usual.
+type ChainConfig struct {
+	config *Config
+	chain  models.Chain
+}
+
+var _ ConfigReader = ChainConfig{}
+
+// NewChainConfig returns the configuration for the given chain.
+func NewChainConfig(config *Config, chain models.Chain) *ChainConfig {
+	return &ChainConfig{config: config, chain: chain}
+}
+
+// ChainID returns the ID of the chain.
+func (c ChainConfig) ChainID() *big.Int {
+	return c.chain.ID.ToInt()
+}
+
+// EthereumURL returns the websocket or HTTP URL of the chain's Ethereum node.
+func (c ChainConfig) EthereumURL() string {
+	return c.chain.EthURL
+}
+
+// BlockBackfillDepth specifies the number of blocks before the current HEAD
+// that the log broadcaster will try to re-consume logs from
+func (c ChainConfig) BlockBackfillDepth() uint64 {
+	if c.chain.Config.BlockBackfillDepth.Valid {
+		return uint64(c.chain.Config.BlockBackfillDepth.Int64)
+	}
+	return c.config.BlockBackfillDepth()
+}
+
+// EthGasBumpThreshold represents the maximum amount a transaction's ETH amount
+// should be increased in order to facilitate a transaction.
+func (c ChainConfig) EthGasBumpThreshold() uint64 {
+	if c.chain.Config.EthGasBumpThreshold.Valid {
+		return uint64(c.chain.Config.EthGasBumpThreshold.Int64)
+	}
+	return c.config.EthGasBumpThreshold()
+}
+
+// EthGasBumpWei represents the intervals in which ETH should be increased when
+// doing gas bumping.
+func (c ChainConfig) EthGasBumpWei() *big.Int {
+	if c.chain.Config.EthGasBumpWei != nil {
+		return c.chain.Config.EthGasBumpWei.ToInt()
+	}
+	return c.config.EthGasBumpWei()
+}
+
+// EthGasLimitDefault sets the default gas limit for outgoing transactions.
+func (c ChainConfig) EthGasLimitDefault() uint64 {
+	if c.chain.Config.EthGasLimitDefault.Valid {
+		return uint64(c.chain.Config.EthGasLimitDefault.Int64)
+	}
+	return c.config.EthGasLimitDefault()
+}
+
+// EthGasPriceDefault is the starting gas price for every transaction on the
+// chain. A value saved at runtime takes precedence over the chain's
+// configured override.
+func (c ChainConfig) EthGasPriceDefault() *big.Int {
+	if c.config.runtimeStore != nil {
+		var value big.Int
+		if err := c.config.runtimeStore.GetConfigValue(c.ethGasPriceDefaultKey(), &value); err != nil && errors.Cause(err) != ErrorNotFound {
+			logger.Warnw("Error while trying to fetch EthGasPriceDefault.", "error", err, "chainID", c.chain.ID)
+		} else if err == nil {
+			return &value
+		}
+	}
+	if c.chain.Config.EthGasPriceDefault != nil {
+		return c.chain.Config.EthGasPriceDefault.ToInt()
+	}
+	return c.config.getWithFallback("EthGasPriceDefault", parseBigInt).(*big.Int)
+}
+
+// SetEthGasPriceDefault saves a runtime value for the default gas price for
+// transactions on the chain
+func (c ChainConfig) SetEthGasPriceDefault(value *big.Int) error {
+	if c.config.runtimeStore == nil {
+		return errors.New("No runtime store installed")
+	}
+	return c.config.runtimeStore.SetConfigValue(c.ethGasPriceDefaultKey(), value)
+}
+
+func (c ChainConfig) ethGasPriceDefaultKey() string {
+	return fmt.Sprintf("EthGasPriceDefault:%s", c.chain.ID)
+}
+
+// EthMaxGasPriceWei is the maximum amount in Wei that a transaction will be
+// bumped to before abandoning it and marking it as errored.
+func (c ChainConfig) EthMaxGasPriceWei() *big.Int {
+	if c.chain.Config.EthMaxGasPriceWei != nil {
+		return c.chain.Config.EthMaxGasPriceWei.ToInt()
+	}
+	return c.config.EthMaxGasPriceWei()
+}
+
+// EthMinGasPriceWei is the lowest gas price in Wei that a gas estimator will
+// return for the chain.
+func (c ChainConfig) EthMinGasPriceWei() *big.Int {
+	if c.chain.Config.EthMinGasPriceWei != nil {
+		return c.chain.Config.EthMinGasPriceWei.ToInt()
+	}
+	return c.config.EthMinGasPriceWei()
+}
+
+// GasEstimator names the strategy used to pick the chain's default gas price.
+func (c ChainConfig) GasEstimator() string {
+	if c.chain.Config.GasEstimator.Valid {
+		return c.chain.Config.GasEstimator.String
+	}
+	return c.config.GasEstimator()
+}
+
+// GasUpdaterEnabled turns on the automatic gas updater for the chain
+func (c ChainConfig) GasUpdaterEnabled() bool {
+	if c.chain.Config.GasUpdaterEnabled.Valid {
+		return c.chain.Config.GasUpdaterEnabled.Bool
+	}
+	return c.config.GasUpdaterEnabled()
+}
+
+// FluxMonitorLinkEthFeed is the address of the aggregator answering the
+// price of LINK in wei on the chain.
+func (c ChainConfig) FluxMonitorLinkEthFeed() *common.Address {
+	if c.chain.Config.FluxMonitorLinkEthFeed != nil {
+		return c.chain.Config.FluxMonitorLinkEthFeed
+	}
+	return c.config.FluxMonitorLinkEthFeed()
+}
+
+// LinkContractAddress represents the address of the LINK token on the chain
+func (c ChainConfig) LinkContractAddress() string {
+	if c.chain.Config.LinkContractAddress.Valid {
+		return c.chain.Config.LinkContractAddress.String
+	}
+	return c.config.LinkContractAddress()
+}
+
+// MinIncomingConfirmations represents the minimum number of block
+// confirmations that need to be recorded since a job run started before a task
+// can proceed.
+func (c ChainConfig) MinIncomingConfirmations() uint32 {
+	if c.chain.Config.MinIncomingConfirmations.Valid {
+		return uint32(c.chain.Config.MinIncomingConfirmations.Int64)
+	}
+	return c.config.MinIncomingConfirmations()
+}
+
+// MinOutgoingConfirmations represents the minimum number of block
+// confirmations that need to be recorded on an outgoing transaction before a
+// task is completed.
+func (c ChainConfig) MinOutgoingConfirmations() uint64 {
+	if c.chain.Config.MinOutgoingConfirmations.Valid {
+		return uint64(c.chain.Config.MinOutgoingConfirmations.Int64)
+	}
+	return c.config.MinOutgoingConfirmations()
+}
+
+// MulticallForwarderAddress is the address of the forwarder contract used to
+// batch fulfillments on the chain.
+func (c ChainConfig) MulticallForwarderAddress() *common.Address {
+	if c.chain.Config.MulticallForwarderAddress != nil {
+		return c.chain.Config.MulticallForwarderAddress
+	}
+	return c.config.MulticallForwarderAddress()
+}
+
+// OracleContractAddress represents the deployed Oracle contract's address on
+// the chain.
+func (c ChainConfig) OracleContractAddress() *common.Address {
+	if c.chain.Config.OracleContractAddress != nil {
+		return c.chain.Config.OracleContractAddress
+	}
+	return c.config.OracleContractAddress()
+}
+
+// The settings below are not overridden per chain, and read the node's
+// Config.
+
+// AllowOrigins returns the node's AllowOrigins.
+func (c ChainConfig) AllowOrigins() string {
+	return c.config.AllowOrigins()
+}
+
+// BalanceMonitorAlertURL returns the node's BalanceMonitorAlertURL.
+func (c ChainConfig) BalanceMonitorAlertURL() *url.URL {
+	return c.config.BalanceMonitorAlertURL()
+}
+
+// BalanceMonitorEnabled returns the node's BalanceMonitorEnabled.
+func (c ChainConfig) BalanceMonitorEnabled() bool {
+	return c.config.BalanceMonitorEnabled()
+}
+
+// BalanceMonitorEthThreshold returns the node's BalanceMonitorEthThreshold.
+func (c ChainConfig) BalanceMonitorEthThreshold() *assets.Eth {
+	return c.config.BalanceMonitorEthThreshold()
+}
+
+// BalanceMonitorLinkThreshold returns the node's BalanceMonitorLinkThreshold.
+func (c ChainConfig) BalanceMonitorLinkThreshold() *assets.Link {
+	return c.config.BalanceMonitorLinkThreshold()
+}
+
+// BridgeResponseURL returns the node's BridgeResponseURL.
+func (c ChainConfig) BridgeResponseURL() *url.URL {
+	return c.config.BridgeResponseURL()
+}
+
+// ClientNodeURL returns the node's ClientNodeURL.
+func (c ChainConfig) ClientNodeURL() string {
+	return c.config.ClientNodeURL()
+}
+
+// DatabaseTimeout returns the node's DatabaseTimeout.
+func (c ChainConfig) DatabaseTimeout() models.Duration {
+	return c.config.DatabaseTimeout()
+}
+
+// DatabaseURL returns the node's DatabaseURL.
+func (c ChainConfig) DatabaseURL() string {
+	return c.config.DatabaseURL()
+}
+
+// DefaultMaxHTTPAttempts returns the node's DefaultMaxHTTPAttempts.
+func (c ChainConfig) DefaultMaxHTTPAttempts() uint {
+	return c.config.DefaultMaxHTTPAttempts()
+}
+
+// DefaultHTTPLimit returns the node's DefaultHTTPLimit.
+func (c ChainConfig) DefaultHTTPLimit() int64 {
+	return c.config.DefaultHTTPLimit()
+}
+
+// DefaultHTTPTimeout returns the node's DefaultHTTPTimeout.
+func (c ChainConfig) DefaultHTTPTimeout() models.Duration {
+	return c.config.DefaultHTTPTimeout()
+}
+
+// Dev returns the node's Dev.
+func (c ChainConfig) Dev() bool {
+	return c.config.Dev()
+}
+
+// FeatureExternalInitiators returns the node's FeatureExternalInitiators.
+func (c ChainConfig) FeatureExternalInitiators() bool {
+	return c.config.FeatureExternalInitiators()
+}
+
+// FeatureFluxMonitor returns the node's FeatureFluxMonitor.
+func (c ChainConfig) FeatureFluxMonitor() bool {
+	return c.config.FeatureFluxMonitor()
+}
+
+// FluxMonitorRoundsRetention returns the node's FluxMonitorRoundsRetention.
+func (c ChainConfig) FluxMonitorRoundsRetention() models.Duration {
+	return c.config.FluxMonitorRoundsRetention()
+}
+
+// FluxMonitorSubmissionGas returns the node's FluxMonitorSubmissionGas.
+func (c ChainConfig) FluxMonitorSubmissionGas() uint64 {
+	return c.config.FluxMonitorSubmissionGas()
+}
+
+// FluxMonitorWatchdogAlertURL returns the node's FluxMonitorWatchdogAlertURL.
+func (c ChainConfig) FluxMonitorWatchdogAlertURL() *url.URL {
+	return c.config.FluxMonitorWatchdogAlertURL()
+}
+
+// MaximumServiceDuration returns the node's MaximumServiceDuration.
+func (c ChainConfig) MaximumServiceDuration() models.Duration {
+	return c.config.MaximumServiceDuration()
+}
+
+// MinimumServiceDuration returns the node's MinimumServiceDuration.
+func (c ChainConfig) MinimumServiceDuration() models.Duration {
+	return c.config.MinimumServiceDuration()
+}
+
+// EnableExperimentalAdapters returns the node's EnableExperimentalAdapters.
+func (c ChainConfig) EnableExperimentalAdapters() bool {
+	return c.config.EnableExperimentalAdapters()
+}
+
+// EthGasBumpPercent returns the node's EthGasBumpPercent.
+func (c ChainConfig) EthGasBumpPercent() uint16 {
+	return c.config.EthGasBumpPercent()
+}
+
+// EthGasEstimationEnabled returns the node's EthGasEstimationEnabled.
+func (c ChainConfig) EthGasEstimationEnabled() bool {
+	return c.config.EthGasEstimationEnabled()
+}
+
+// EthGasLimitMax returns the node's EthGasLimitMax.
+func (c ChainConfig) EthGasLimitMax() uint64 {
+	return c.config.EthGasLimitMax()
+}
+
+// EthGasLimitMultiplier returns the node's EthGasLimitMultiplier.
+func (c ChainConfig) EthGasLimitMultiplier() float64 {
+	return c.config.EthGasLimitMultiplier()
+}
+
+// EthPollingInterval returns the node's EthPollingInterval.
+func (c ChainConfig) EthPollingInterval() models.Duration {
+	return c.config.EthPollingInterval()
+}
+
+// EthPollingMaxBlockRange returns the node's EthPollingMaxBlockRange.
+func (c ChainConfig) EthPollingMaxBlockRange() uint64 {
+	return c.config.EthPollingMaxBlockRange()
+}
+
+// EthRemoteSignerURL returns the node's EthRemoteSignerURL.
+func (c ChainConfig) EthRemoteSignerURL() string {
+	return c.config.EthRemoteSignerURL()
+}
+
+// EthRemoteSignerCheckInterval returns the node's EthRemoteSignerCheckInterval.
+func (c ChainConfig) EthRemoteSignerCheckInterval() models.Duration {
+	return c.config.EthRemoteSignerCheckInterval()
+}
+
+// EthTxBatchWindow returns the node's EthTxBatchWindow.
+func (c ChainConfig) EthTxBatchWindow() models.Duration {
+	return c.config.EthTxBatchWindow()
+}
+
+// GasOracleMultiplier returns the node's GasOracleMultiplier.
+func (c ChainConfig) GasOracleMultiplier() float64 {
+	return c.config.GasOracleMultiplier()
+}
+
+// GasOraclePath returns the node's GasOraclePath.
+func (c ChainConfig) GasOraclePath() string {
+	return c.config.GasOraclePath()
+}
+
+// GasOracleURL returns the node's GasOracleURL.
+func (c ChainConfig) GasOracleURL() *url.URL {
+	return c.config.GasOracleURL()
+}
+
+// GasUpdaterBlockDelay returns the node's GasUpdaterBlockDelay.
+func (c ChainConfig) GasUpdaterBlockDelay() uint16 {
+	return c.config.GasUpdaterBlockDelay()
+}
+
+// GasUpdaterBlockHistorySize returns the node's GasUpdaterBlockHistorySize.
+func (c ChainConfig) GasUpdaterBlockHistorySize() uint16 {
+	return c.config.GasUpdaterBlockHistorySize()
+}
+
+// GasUpdaterTransactionPercentile returns the node's GasUpdaterTransactionPercentile.
+func (c ChainConfig) GasUpdaterTransactionPercentile() uint16 {
+	return c.config.GasUpdaterTransactionPercentile()
+}
+
+// JSONConsole returns the node's JSONConsole.
+func (c ChainConfig) JSONConsole() bool {
+	return c.config.JSONConsole()
+}
+
+// ExplorerURL returns the node's ExplorerURL.
+func (c ChainConfig) ExplorerURL() *url.URL {
+	return c.config.ExplorerURL()
+}
+
+// ExplorerAccessKey returns the node's ExplorerAccessKey.
+func (c ChainConfig) ExplorerAccessKey() string {
+	return c.config.ExplorerAccessKey()
+}
+
+// ExplorerSecret returns the node's ExplorerSecret.
+func (c ChainConfig) ExplorerSecret() string {
+	return c.config.ExplorerSecret()
+}
+
+// LogLevel returns the node's LogLevel.
+func (c ChainConfig) LogLevel() LogLevel {
+	return c.config.LogLevel()
+}
+
+// LogConsumptionRetention returns the node's LogConsumptionRetention.
+func (c ChainConfig) LogConsumptionRetention() models.Duration {
+	return c.config.LogConsumptionRetention()
+}
+
+// LogToDisk returns the node's LogToDisk.
+func (c ChainConfig) LogToDisk() bool {
+	return c.config.LogToDisk()
+}
+
+// LogSQLStatements returns the node's LogSQLStatements.
+func (c ChainConfig) LogSQLStatements() bool {
+	return c.config.LogSQLStatements()
+}
+
+// MinimumContractPayment returns the node's MinimumContractPayment.
+func (c ChainConfig) MinimumContractPayment() *assets.Link {
+	return c.config.MinimumContractPayment()
+}
+
+// MinimumRequestExpiration returns the node's MinimumRequestExpiration.
+func (c ChainConfig) MinimumRequestExpiration() uint64 {
+	return c.config.MinimumRequestExpiration()
+}
+
+// MigrateDatabase returns the node's MigrateDatabase.
+func (c ChainConfig) MigrateDatabase() bool {
+	return c.config.MigrateDatabase()
+}
+
+// Port returns the node's Port.
+func (c ChainConfig) Port() uint16 {
+	return c.config.Port()
+}
+
+// ReaperExpiration returns the node's ReaperExpiration.
+func (c ChainConfig) ReaperExpiration() models.Duration {
+	return c.config.ReaperExpiration()
+}
+
+// RootDir returns the node's RootDir.
+func (c ChainConfig) RootDir() string {
+	return c.config.RootDir()
+}
+
+// SecureCookies returns the node's SecureCookies.
+func (c ChainConfig) SecureCookies() bool {
+	return c.config.SecureCookies()
+}
+
+// SessionTimeout returns the node's SessionTimeout.
+func (c ChainConfig) SessionTimeout() models.Duration {
+	return c.config.SessionTimeout()
+}
+
+// TLSCertPath returns the node's TLSCertPath.
+func (c ChainConfig) TLSCertPath() string {
+	return c.config.TLSCertPath()
+}
+
+// TLSHost returns the node's TLSHost.
+func (c ChainConfig) TLSHost() string {
+	return c.config.TLSHost()
+}
+
+// TLSKeyPath returns the node's TLSKeyPath.
+func (c ChainConfig) TLSKeyPath() string {
+	return c.config.TLSKeyPath()
+}
+
+// TLSPort returns the node's TLSPort.
+func (c ChainConfig) TLSPort() uint16 {
+	return c.config.TLSPort()
+}
+
+// TLSRedirect returns the node's TLSRedirect.
+func (c ChainConfig) TLSRedirect() bool {
+	return c.config.TLSRedirect()
+}
+
+// TxAttemptLimit returns the node's TxAttemptLimit.
+func (c ChainConfig) TxAttemptLimit() uint16 {
+	return c.config.TxAttemptLimit()
+}
+
+// KeysDir returns the node's KeysDir.
+func (c ChainConfig) KeysDir() string {
+	return c.config.KeysDir()
+}
+
+// tlsDir returns the node's tlsDir.
+func (c ChainConfig) tlsDir() string {
+	return c.config.tlsDir()
+}
+
+// KeyFile returns the node's KeyFile.
+func (c ChainConfig) KeyFile() string {
+	return c.config.KeyFile()
+}
+
+// CertFile returns the node's CertFile.
+func (c ChainConfig) CertFile() string {
+	return c.config.CertFile()
+}
+
+// CreateProductionLogger returns the node's CreateProductionLogger.
+func (c ChainConfig) CreateProductionLogger() *zap.Logger {
+	return c.config.CreateProductionLogger()
+}
+
+// SessionSecret returns the node's SessionSecret.
+func (c ChainConfig) SessionSecret() ([]byte, error) {
+	return c.config.SessionSecret()
+}
+
+// SessionOptions returns the node's SessionOptions.
+func (c ChainConfig) SessionOptions() sessions.Options {
+	return c.config.SessionOptions()
+}
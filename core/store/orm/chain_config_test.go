@@ -0,0 +1,49 @@
+package orm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	null "gopkg.in/guregu/null.v3"
+)
+
+func TestChainConfig_Overrides(t *testing.T) {
+	config := NewConfig()
+	chain := models.NewChain(big.NewInt(42), "ws://chain42:8546")
+	oracle := common.HexToAddress("0x0000000000000000000000000000000000000042")
+	linkEthFeed := common.HexToAddress("0x0000000000000000000000000000000000000043")
+	chain.Config = models.ChainCfg{
+		EthGasBumpWei:            utils.NewBig(big.NewInt(7)),
+		FluxMonitorLinkEthFeed:   &linkEthFeed,
+		GasUpdaterEnabled:        null.BoolFrom(true),
+		MinIncomingConfirmations: null.IntFrom(12),
+		OracleContractAddress:    &oracle,
+	}
+	chainConfig := NewChainConfig(config, chain)
+
+	assert.Equal(t, big.NewInt(42), chainConfig.ChainID())
+	assert.Equal(t, "ws://chain42:8546", chainConfig.EthereumURL())
+	assert.Equal(t, big.NewInt(7), chainConfig.EthGasBumpWei())
+	assert.Equal(t, &linkEthFeed, chainConfig.FluxMonitorLinkEthFeed())
+	assert.True(t, chainConfig.GasUpdaterEnabled())
+	assert.Equal(t, uint32(12), chainConfig.MinIncomingConfirmations())
+	assert.Equal(t, &oracle, chainConfig.OracleContractAddress())
+}
+
+func TestChainConfig_FallsBackToConfig(t *testing.T) {
+	config := NewConfig()
+	chainConfig := NewChainConfig(config, models.NewChain(big.NewInt(42), "ws://chain42:8546"))
+
+	assert.Equal(t, config.BlockBackfillDepth(), chainConfig.BlockBackfillDepth())
+	assert.Equal(t, config.EthGasBumpWei(), chainConfig.EthGasBumpWei())
+	assert.Equal(t, config.EthGasPriceDefault(), chainConfig.EthGasPriceDefault())
+	assert.Nil(t, chainConfig.FluxMonitorLinkEthFeed())
+	assert.Equal(t, config.GasUpdaterEnabled(), chainConfig.GasUpdaterEnabled())
+	assert.Equal(t, config.LinkContractAddress(), chainConfig.LinkContractAddress())
+	assert.Equal(t, config.MinOutgoingConfirmations(), chainConfig.MinOutgoingConfirmations())
+}
@@ -93,6 +93,17 @@ func (c *Config) Validate() error {
 			ethCore.DefaultTxPoolConfig.PriceBump,
 		)
 	}
+	if c.EthGasLimitMultiplier() < 1 {
+		return fmt.Errorf("ETH_GAS_LIMIT_MULTIPLIER of %v must be at least 1, or transactions would be sent with less gas than they are estimated to use", c.EthGasLimitMultiplier())
+	}
+	if interval := c.viper.GetDuration(EnvVarName("EthPollingInterval")); interval <= 0 {
+		return fmt.Errorf("ETH_POLLING_INTERVAL of %v must be positive", interval)
+	}
+	if c.EthRemoteSignerURL() != "" {
+		if interval := c.viper.GetDuration(EnvVarName("EthRemoteSignerCheckInterval")); interval <= 0 {
+			return fmt.Errorf("ETH_REMOTE_SIGNER_CHECK_INTERVAL of %v must be positive when ETH_REMOTE_SIGNER_URL is set", interval)
+		}
+	}
 	return nil
 }
 
@@ -139,6 +150,40 @@ func (c Config) AllowOrigins() string {
 	return c.viper.GetString(EnvVarName("AllowOrigins"))
 }
 
+// BalanceMonitorAlertURL is the URL the balance monitor POSTs low balance
+// alerts to, or nil to only log them.
+func (c Config) BalanceMonitorAlertURL() *url.URL {
+	rval := c.getWithFallback("BalanceMonitorAlertURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: BalanceMonitorAlertURL returned as type %T", rval)
+		return nil
+	}
+}
+
+// BalanceMonitorEnabled makes the node check the ETH and LINK balances of
+// its accounts on every new head of each chain, at the cost of two calls to
+// the ethereum node per account and head. Enabled by default.
+func (c Config) BalanceMonitorEnabled() bool {
+	return c.viper.GetBool(EnvVarName("BalanceMonitorEnabled"))
+}
+
+// BalanceMonitorEthThreshold is the ETH balance, in Wei, below which an
+// account's balance is reported as low. 0 disables ETH alerts.
+func (c Config) BalanceMonitorEthThreshold() *assets.Eth {
+	return (*assets.Eth)(c.getWithFallback("BalanceMonitorEthThreshold", parseBigInt).(*big.Int))
+}
+
+// BalanceMonitorLinkThreshold is the LINK balance, in Juels, below which an
+// account's balance is reported as low. 0 disables LINK alerts.
+func (c Config) BalanceMonitorLinkThreshold() *assets.Link {
+	return c.getWithFallback("BalanceMonitorLinkThreshold", parseLink).(*assets.Link)
+}
+
 // BlockBackfillDepth specifies the number of blocks before the current HEAD that the
 // log broadcaster will try to re-consume logs from
 func (c Config) BlockBackfillDepth() uint64 {
@@ -220,11 +265,55 @@ func (c Config) FeatureFluxMonitor() bool {
 	return c.viper.GetBool(EnvVarName("FeatureFluxMonitor"))
 }
 
+// FluxMonitorLinkEthFeed is the address of the aggregator answering the
+// price of LINK in wei. When set, flux monitor jobs skip submissions whose
+// estimated gas cost exceeds their payment.
+func (c Config) FluxMonitorLinkEthFeed() *common.Address {
+	if c.viper.GetString(EnvVarName("FluxMonitorLinkEthFeed")) == "" {
+		return nil
+	}
+	return c.getWithFallback("FluxMonitorLinkEthFeed", parseAddress).(*common.Address)
+}
+
+// FluxMonitorRoundsRetention is how long the decisions recorded by flux
+// monitor jobs are kept.
+func (c Config) FluxMonitorRoundsRetention() models.Duration {
+	return c.getDuration("FluxMonitorRoundsRetention")
+}
+
+// FluxMonitorSubmissionGas is the gas expected to be used by a flux monitor
+// submission, to estimate its cost.
+func (c Config) FluxMonitorSubmissionGas() uint64 {
+	return c.viper.GetUint64(EnvVarName("FluxMonitorSubmissionGas"))
+}
+
+// FluxMonitorWatchdogAlertURL is the URL flux monitor watchdogs POST their
+// alerts to, or nil to only log them.
+func (c Config) FluxMonitorWatchdogAlertURL() *url.URL {
+	rval := c.getWithFallback("FluxMonitorWatchdogAlertURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: FluxMonitorWatchdogAlertURL returned as type %T", rval)
+		return nil
+	}
+}
+
 // MaxRPCCallsPerSecond returns the rate at which RPC calls can be fired
 func (c Config) MaxRPCCallsPerSecond() uint64 {
 	return c.viper.GetUint64(EnvVarName("MaxRPCCallsPerSecond"))
 }
 
+// MaxRPCBatchSize is the largest number of concurrent RPC calls sent to the
+// ethereum node in a single JSON-RPC batch request. Batching is disabled if
+// this is set to 0 or 1.
+func (c Config) MaxRPCBatchSize() uint16 {
+	return c.getWithFallback("MaxRPCBatchSize", parseUint16).(uint16)
+}
+
 // MaximumServiceDuration is the maximum time that a service agreement can run
 // from after the time it is created. Default 1 year = 365 * 24h = 8760h
 func (c Config) MaximumServiceDuration() models.Duration {
@@ -259,11 +348,67 @@ func (c Config) EthMaxGasPriceWei() *big.Int {
 	return c.getWithFallback("EthMaxGasPriceWei", parseBigInt).(*big.Int)
 }
 
+// EthMinGasPriceWei is the lowest gas price in Wei that a gas estimator will
+// return. Estimates below it are raised to it.
+func (c Config) EthMinGasPriceWei() *big.Int {
+	return c.getWithFallback("EthMinGasPriceWei", parseBigInt).(*big.Int)
+}
+
+// EthPollingInterval is how often the node polls the ethereum node for new
+// heads and logs when ETH_URL is an HTTP endpoint, which cannot push them.
+func (c Config) EthPollingInterval() models.Duration {
+	return c.getDuration("EthPollingInterval")
+}
+
+// EthPollingMaxBlockRange is the largest number of blocks whose heads or
+// logs are fetched by a single poll of an HTTP endpoint.
+func (c Config) EthPollingMaxBlockRange() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthPollingMaxBlockRange"))
+}
+
+// EthRemoteSignerURL is the JSON-RPC endpoint of a Clef compatible signer
+// holding the node's ethereum keys. If empty, the keys are kept on disk.
+func (c Config) EthRemoteSignerURL() string {
+	return c.viper.GetString(EnvVarName("EthRemoteSignerURL"))
+}
+
+// EthRemoteSignerCheckInterval is how often the health of the remote signer
+// is checked and its accounts discovered again.
+func (c Config) EthRemoteSignerCheckInterval() models.Duration {
+	return c.getDuration("EthRemoteSignerCheckInterval")
+}
+
+// EthTxBatchWindow is how long fulfillments to the same oracle contract are
+// collected before being sent together through the multicall forwarder.
+// Batching is disabled if this is 0.
+func (c Config) EthTxBatchWindow() models.Duration {
+	return c.getDuration("EthTxBatchWindow")
+}
+
 // EthGasLimitDefault  sets the default gas limit for outgoing transactions.
 func (c Config) EthGasLimitDefault() uint64 {
 	return c.viper.GetUint64(EnvVarName("EthGasLimitDefault"))
 }
 
+// EthGasEstimationEnabled makes the node ask the ethereum node for the gas
+// limit of each new transaction, only falling back to ETH_GAS_LIMIT_DEFAULT
+// when estimation fails.
+func (c Config) EthGasEstimationEnabled() bool {
+	return c.viper.GetBool(EnvVarName("EthGasEstimationEnabled"))
+}
+
+// EthGasLimitMax is the highest gas limit a transaction is sent with when its
+// gas limit is estimated.
+func (c Config) EthGasLimitMax() uint64 {
+	return c.viper.GetUint64(EnvVarName("EthGasLimitMax"))
+}
+
+// EthGasLimitMultiplier is the safety margin applied to gas estimates, e.g.
+// 1.25 sends transactions with a gas limit 25% above the estimate.
+func (c Config) EthGasLimitMultiplier() float64 {
+	return c.viper.GetFloat64(EnvVarName("EthGasLimitMultiplier"))
+}
+
 // EthGasPriceDefault is the starting gas price for every transaction
 func (c Config) EthGasPriceDefault() *big.Int {
 	if c.runtimeStore != nil {
@@ -295,6 +440,39 @@ func (c Config) EthereumDisabled() bool {
 	return c.viper.GetBool(EnvVarName("EthereumDisabled"))
 }
 
+// GasEstimator names the strategy used to pick the default gas price: fixed,
+// blockhistory, gasoracle or feehistory. If unset, blockhistory is used when
+// GAS_UPDATER_ENABLED is true, and fixed otherwise.
+func (c Config) GasEstimator() string {
+	return c.viper.GetString(EnvVarName("GasEstimator"))
+}
+
+// GasOracleMultiplier converts the price returned by the gas oracle to Wei,
+// e.g. 1000000000 for an oracle quoting prices in Gwei.
+func (c Config) GasOracleMultiplier() float64 {
+	return c.viper.GetFloat64(EnvVarName("GasOracleMultiplier"))
+}
+
+// GasOraclePath is the GJSON path of the gas price in the gas oracle's
+// response.
+func (c Config) GasOraclePath() string {
+	return c.viper.GetString(EnvVarName("GasOraclePath"))
+}
+
+// GasOracleURL is the HTTP endpoint queried by the gasoracle estimator, or nil.
+func (c Config) GasOracleURL() *url.URL {
+	rval := c.getWithFallback("GasOracleURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: GasOracleURL returned as type %T", rval)
+		return nil
+	}
+}
+
 // GasUpdaterBlockDelay is the number of blocks that the gas updater trails behind head.
 // E.g. if this is set to 3, and we receive block 10, gas updater will
 // fetch block 7.
@@ -359,6 +537,16 @@ func (c Config) ExplorerSecret() string {
 	return c.viper.GetString(EnvVarName("ExplorerSecret"))
 }
 
+// MulticallForwarderAddress is the address of the forwarder contract used to
+// bundle oracle fulfillments into a single transaction when
+// ETH_TX_BATCH_WINDOW is set.
+func (c Config) MulticallForwarderAddress() *common.Address {
+	if c.viper.GetString(EnvVarName("MulticallForwarderAddress")) == "" {
+		return nil
+	}
+	return c.getWithFallback("MulticallForwarderAddress", parseAddress).(*common.Address)
+}
+
 // OracleContractAddress represents the deployed Oracle contract's address.
 func (c Config) OracleContractAddress() *common.Address {
 	if c.viper.GetString(EnvVarName("OracleContractAddress")) == "" {
@@ -372,6 +560,12 @@ func (c Config) LogLevel() LogLevel {
 	return c.getWithFallback("LogLevel", parseLogLevel).(LogLevel)
 }
 
+// LogConsumptionRetention is how long the records of the logs consumed by
+// jobs are kept. Replaying logs older than this may run their jobs again.
+func (c Config) LogConsumptionRetention() models.Duration {
+	return c.getDuration("LogConsumptionRetention")
+}
+
 // LogToDisk configures disk preservation of logs.
 func (c Config) LogToDisk() bool {
 	return c.viper.GetBool(EnvVarName("LogToDisk"))
@@ -15,6 +15,10 @@ import (
 // ConfigReader represents just the read side of the config
 type ConfigReader interface {
 	AllowOrigins() string
+	BalanceMonitorAlertURL() *url.URL
+	BalanceMonitorEnabled() bool
+	BalanceMonitorEthThreshold() *assets.Eth
+	BalanceMonitorLinkThreshold() *assets.Link
 	BlockBackfillDepth() uint64
 	BridgeResponseURL() *url.URL
 	ChainID() *big.Int
@@ -27,17 +31,35 @@ type ConfigReader interface {
 	Dev() bool
 	FeatureExternalInitiators() bool
 	FeatureFluxMonitor() bool
+	FluxMonitorLinkEthFeed() *common.Address
+	FluxMonitorRoundsRetention() models.Duration
+	FluxMonitorSubmissionGas() uint64
+	FluxMonitorWatchdogAlertURL() *url.URL
 	MaximumServiceDuration() models.Duration
 	MinimumServiceDuration() models.Duration
 	EnableExperimentalAdapters() bool
 	EthGasBumpPercent() uint16
 	EthGasBumpThreshold() uint64
 	EthGasBumpWei() *big.Int
+	EthGasEstimationEnabled() bool
 	EthGasLimitDefault() uint64
+	EthGasLimitMax() uint64
+	EthGasLimitMultiplier() float64
 	EthGasPriceDefault() *big.Int
 	EthMaxGasPriceWei() *big.Int
+	EthMinGasPriceWei() *big.Int
+	EthPollingInterval() models.Duration
+	EthPollingMaxBlockRange() uint64
+	EthRemoteSignerURL() string
+	EthRemoteSignerCheckInterval() models.Duration
+	EthTxBatchWindow() models.Duration
 	SetEthGasPriceDefault(value *big.Int) error
 	EthereumURL() string
+	GasEstimator() string
+	GasOracleMultiplier() float64
+	GasOraclePath() string
+	GasOracleURL() *url.URL
+	GasUpdaterEnabled() bool
 	GasUpdaterBlockDelay() uint16
 	GasUpdaterBlockHistorySize() uint16
 	GasUpdaterTransactionPercentile() uint16
@@ -48,12 +70,14 @@ type ConfigReader interface {
 	ExplorerSecret() string
 	OracleContractAddress() *common.Address
 	LogLevel() LogLevel
+	LogConsumptionRetention() models.Duration
 	LogToDisk() bool
 	LogSQLStatements() bool
 	MinIncomingConfirmations() uint32
 	MinOutgoingConfirmations() uint64
 	MinimumContractPayment() *assets.Link
 	MinimumRequestExpiration() uint64
+	MulticallForwarderAddress() *common.Address
 	MigrateDatabase() bool
 	Port() uint16
 	ReaperExpiration() models.Duration
@@ -30,6 +30,33 @@ func TestStore_ConfigDefaults(t *testing.T) {
 	assert.Equal(t, 15*time.Minute, config.SessionTimeout().Duration())
 }
 
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+	assert.NoError(t, config.Validate())
+
+	config.Set("ETH_GAS_LIMIT_MULTIPLIER", 0)
+	assert.Error(t, config.Validate())
+	config.Set("ETH_GAS_LIMIT_MULTIPLIER", 0.9)
+	assert.Error(t, config.Validate())
+	config.Set("ETH_GAS_LIMIT_MULTIPLIER", 1)
+	assert.NoError(t, config.Validate())
+
+	config.Set("ETH_POLLING_INTERVAL", "0s")
+	assert.Error(t, config.Validate())
+	config.Set("ETH_POLLING_INTERVAL", "-1s")
+	assert.Error(t, config.Validate())
+	config.Set("ETH_POLLING_INTERVAL", "1s")
+	assert.NoError(t, config.Validate())
+
+	config.Set("ETH_REMOTE_SIGNER_CHECK_INTERVAL", "0s")
+	assert.NoError(t, config.Validate())
+	config.Set("ETH_REMOTE_SIGNER_URL", "http://localhost:8550")
+	assert.Error(t, config.Validate())
+	config.Set("ETH_REMOTE_SIGNER_CHECK_INTERVAL", "30s")
+	assert.NoError(t, config.Validate())
+}
+
 func TestConfig_sessionSecret(t *testing.T) {
 	t.Parallel()
 	config := NewConfig()
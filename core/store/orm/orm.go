@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding"
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"sync"
@@ -491,6 +492,15 @@ func (orm *ORM) ArchiveJob(ID *models.ID) error {
 	})
 }
 
+// SetFluxMonitorPaused pauses or resumes the flux monitor initiators of the
+// job.
+func (orm *ORM) SetFluxMonitorPaused(jobID *models.ID, paused bool) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(&models.Initiator{}).
+		Where("job_spec_id = ? AND type = ?", jobID, models.InitiatorFluxMonitor).
+		Update("paused", paused).Error
+}
+
 // CreateServiceAgreement saves a Service Agreement, its JobSpec and its
 // associations to the database.
 func (orm *ORM) CreateServiceAgreement(sa *models.ServiceAgreement) error {
@@ -592,10 +602,11 @@ func (orm *ORM) AddTxAttempt(tx *models.Tx, newTxAttempt *models.Tx) (*models.Tx
 	tx.SentAt = newTxAttempt.SentAt
 	tx.SignedRawTx = newTxAttempt.SignedRawTx
 	txAttempt := &models.TxAttempt{
-		Hash:        newTxAttempt.Hash,
-		GasPrice:    newTxAttempt.GasPrice,
-		SentAt:      newTxAttempt.SentAt,
-		SignedRawTx: newTxAttempt.SignedRawTx,
+		Hash:         newTxAttempt.Hash,
+		GasPrice:     newTxAttempt.GasPrice,
+		SentAt:       newTxAttempt.SentAt,
+		SignedRawTx:  newTxAttempt.SignedRawTx,
+		Cancellation: tx.Cancellation != models.TxCancellationNone,
 	}
 	tx.Attempts = append(tx.Attempts, txAttempt)
 
@@ -632,6 +643,138 @@ func (orm *ORM) FindTx(ID uint64) (*models.Tx, error) {
 	return tx, err
 }
 
+// TxsBySurrogateID returns the transactions sent for the given surrogate ID,
+// usually that of a job run, oldest first.
+func (orm *ORM) TxsBySurrogateID(surrogateID string) ([]models.Tx, error) {
+	orm.MustEnsureAdvisoryLock()
+	var txs []models.Tx
+	err := preloadAttempts(orm.db).
+		Where("surrogate_id = ?", surrogateID).
+		Order("id asc").
+		Find(&txs).Error
+	return txs, err
+}
+
+// CreateTxBatchCall records the call of a job run queued for a batched
+// transaction, replacing the previous call of the run if any.
+func (orm *ORM) CreateTxBatchCall(call *models.TxBatchCall) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		if err := dbtx.Where("job_run_id = ?", call.JobRunID).Delete(models.TxBatchCall{}).Error; err != nil {
+			return errors.Wrap(err, "CreateTxBatchCall#Delete failed")
+		}
+		return dbtx.Create(call).Error
+	})
+}
+
+// FindTxBatchCall returns the call of the job run within a batched
+// transaction.
+func (orm *ORM) FindTxBatchCall(jobRunID *models.ID) (*models.TxBatchCall, error) {
+	orm.MustEnsureAdvisoryLock()
+	call := &models.TxBatchCall{}
+	err := orm.db.First(call, "job_run_id = ?", jobRunID).Error
+	return call, err
+}
+
+// MarkTxBatchCallsSent links the calls of a batch to the transaction they were
+// sent in.
+func (orm *ORM) MarkTxBatchCallsSent(callIDs []uint64, txID uint64) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(&models.TxBatchCall{}).
+		Where("id IN (?)", callIDs).
+		Update("tx_id", txID).Error
+}
+
+// MarkTxBatchCallsFailed records why the calls of a batch could not be sent.
+func (orm *ORM) MarkTxBatchCallsFailed(callIDs []uint64, reason string) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(&models.TxBatchCall{}).
+		Where("id IN (?)", callIDs).
+		Update("error", reason).Error
+}
+
+// FailUnsentTxBatchCalls marks the calls that were still waiting for their
+// batch to be sent when the node stopped as failed, since the batches were
+// only held in memory.
+func (orm *ORM) FailUnsentTxBatchCalls() error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(&models.TxBatchCall{}).
+		Where("tx_id IS NULL AND error IS NULL").
+		Update("error", "the node stopped before the batch was sent").Error
+}
+
+// CreateFluxMonitorRound records a decision of the flux monitor of a job.
+func (orm *ORM) CreateFluxMonitorRound(round *models.FluxMonitorRound) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Create(round).Error
+}
+
+// DeleteFluxMonitorRoundsBefore deletes the flux monitor decisions recorded
+// before the passed time.
+func (orm *ORM) DeleteFluxMonitorRoundsBefore(before time.Time) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Where("created_at < ?", before).Delete(models.FluxMonitorRound{}).Error
+}
+
+// FluxMonitorRoundsFor returns the most recent decisions of the flux monitor
+// of the given job first, along with the hashes of the transactions of their
+// submissions, limited by passed parameters.
+func (orm *ORM) FluxMonitorRoundsFor(jobSpecID *models.ID, offset, limit int) ([]models.FluxMonitorRound, int, error) {
+	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("job_spec_id = ?", jobSpecID)
+
+	var count int
+	if err := scope.Model(&models.FluxMonitorRound{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rounds []models.FluxMonitorRound
+	err := scope.Order("id desc").Limit(limit).Offset(offset).Find(&rounds).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var runIDs []string
+	for _, round := range rounds {
+		if round.JobRunID != nil {
+			runIDs = append(runIDs, round.JobRunID.String())
+		}
+	}
+	if len(runIDs) == 0 {
+		return rounds, count, nil
+	}
+	var txs []models.Tx
+	if err = orm.db.Where("surrogate_id IN (?)", runIDs).Find(&txs).Error; err != nil {
+		return nil, 0, err
+	}
+	hashes := make(map[string]common.Hash, len(txs))
+	for _, tx := range txs {
+		hashes[tx.SurrogateID.String] = tx.Hash
+	}
+	for i, round := range rounds {
+		if round.JobRunID == nil {
+			continue
+		}
+		if hash, ok := hashes[round.JobRunID.String()]; ok {
+			rounds[i].TxHash = &hash
+		}
+	}
+	return rounds, count, nil
+}
+
+// PendingTxCancellations returns the transactions of the given chain being
+// replaced by a no-op whose outcome is not known yet.
+func (orm *ORM) PendingTxCancellations(chainID *big.Int) ([]models.Tx, error) {
+	orm.MustEnsureAdvisoryLock()
+	var txs []models.Tx
+	err := preloadAttempts(orm.db).
+		Where("cancellation = ? AND confirmed = ?", models.TxCancellationPending, false).
+		Where("chain_id IS NOT DISTINCT FROM ?", utils.NewBig(chainID)).
+		Order("id asc").
+		Find(&txs).Error
+	return txs, err
+}
+
 // FindAllTxsInNonceRange returns an array of transactions matching the inclusive range between beginningNonce and endingNonce
 func (orm *ORM) FindAllTxsInNonceRange(beginningNonce uint, endingNonce uint) ([]models.Tx, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -706,6 +849,13 @@ func (orm *ORM) MarkRan(i *models.Initiator, ran bool) error {
 	})
 }
 
+// SetAggregatorVersion records the aggregator version detected for a flux
+// monitor initiator.
+func (orm *ORM) SetAggregatorVersion(i *models.Initiator, version string) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(i).UpdateColumn("aggregator_version", version).Error
+}
+
 // FindUser will return the one API user, or an error.
 func (orm *ORM) FindUser() (models.User, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -852,6 +1002,22 @@ func (orm *ORM) Transactions(offset, limit int) ([]models.Tx, int, error) {
 	return txs, count, err
 }
 
+// ChainTransactions returns the transactions of the given chain limited by
+// passed parameters.
+func (orm *ORM) ChainTransactions(chainID *big.Int, offset, limit int) ([]models.Tx, int, error) {
+	orm.MustEnsureAdvisoryLock()
+	scope := orm.db.Where("chain_id = ?", utils.NewBig(chainID))
+
+	var count int
+	if err := scope.Model(&models.Tx{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var txs []models.Tx
+	err := scope.Order("id desc").Limit(limit).Offset(offset).Find(&txs).Error
+	return txs, count, err
+}
+
 // TxAttempts returns the last tx attempts sorted by sent at descending.
 func (orm *ORM) TxAttempts(offset, limit int) ([]models.TxAttempt, int, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -865,8 +1031,9 @@ func (orm *ORM) TxAttempts(offset, limit int) ([]models.TxAttempt, int, error) {
 	return attempts, count, err
 }
 
-// UnconfirmedTxAttempts returns all TxAttempts for which the associated Tx is still unconfirmed.
-func (orm *ORM) UnconfirmedTxAttempts() ([]models.TxAttempt, error) {
+// UnconfirmedTxAttempts returns all TxAttempts on the given chain for which
+// the associated Tx is still unconfirmed.
+func (orm *ORM) UnconfirmedTxAttempts(chainID *big.Int) ([]models.TxAttempt, error) {
 	orm.MustEnsureAdvisoryLock()
 	var items []models.TxAttempt
 
@@ -874,6 +1041,7 @@ func (orm *ORM) UnconfirmedTxAttempts() ([]models.TxAttempt, error) {
 		Preload("Tx").
 		Joins("inner join txes on txes.id = tx_attempts.tx_id").
 		Where("txes.confirmed = ?", false).
+		Where("txes.chain_id IS NOT DISTINCT FROM ?", utils.NewBig(chainID)).
 		Find(&items).Error
 	if err != nil {
 		return nil, err
@@ -980,28 +1148,98 @@ func (orm *ORM) CreateHead(n *models.Head) error {
 	return orm.db.Create(n).Error
 }
 
-// FirstHead returns the oldest persisted head entry.
-func (orm *ORM) FirstHead() (*models.Head, error) {
+// FirstHead returns the oldest persisted head entry of the given chain.
+func (orm *ORM) FirstHead(chainID *big.Int) (*models.Head, error) {
 	orm.MustEnsureAdvisoryLock()
 	number := &models.Head{}
-	err := orm.db.Order("number asc").First(number).Error
+	err := orm.headsOnChain(chainID).
+		Order("number asc").
+		First(number).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
 	return number, err
 }
 
-// LastHead returns the most recently persisted head entry.
-func (orm *ORM) LastHead() (*models.Head, error) {
+// LastHead returns the most recently persisted head entry of the given chain.
+func (orm *ORM) LastHead(chainID *big.Int) (*models.Head, error) {
 	orm.MustEnsureAdvisoryLock()
 	number := &models.Head{}
-	err := orm.db.Order("number desc").First(number).Error
+	err := orm.headsOnChain(chainID).
+		Order("number desc").
+		First(number).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
 	return number, err
 }
 
+// HeadByHash returns the persisted head of the given chain with the given
+// hash, or nil if there is none.
+func (orm *ORM) HeadByHash(chainID *big.Int, hash common.Hash) (*models.Head, error) {
+	orm.MustEnsureAdvisoryLock()
+	head := &models.Head{}
+	err := orm.headsOnChain(chainID).
+		Where("hash = ?", hash).
+		First(head).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return head, err
+}
+
+// DeleteHeadsAbove deletes the persisted heads of the given chain with a
+// number greater than the given one, e.g. after they were orphaned by a
+// reorganisation.
+func (orm *ORM) DeleteHeadsAbove(chainID *big.Int, number int64) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.headsOnChain(chainID).
+		Where("number > ?", number).
+		Delete(models.Head{}).Error
+}
+
+// headsOnChain scopes a query of the heads table to the given chain.
+func (orm *ORM) headsOnChain(chainID *big.Int) *gorm.DB {
+	return orm.db.Where("chain_id = ?", utils.NewBig(chainID))
+}
+
+// AssignDefaultChainID scopes the transactions saved before the node served
+// multiple chains to the default chain.
+func (orm *ORM) AssignDefaultChainID(chainID *big.Int) error {
+	orm.MustEnsureAdvisoryLock()
+	return errors.Wrap(
+		orm.db.Exec(`UPDATE txes SET chain_id = ? WHERE chain_id IS NULL`, utils.NewBig(chainID)).Error,
+		"AssignDefaultChainID txes",
+	)
+}
+
+// CreateChain saves a new chain to be served by the node.
+func (orm *ORM) CreateChain(chain *models.Chain) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Create(chain).Error
+}
+
+// FindChain looks up a chain by its ID.
+func (orm *ORM) FindChain(id *big.Int) (models.Chain, error) {
+	orm.MustEnsureAdvisoryLock()
+	var chain models.Chain
+	err := orm.db.First(&chain, "id = ?", utils.NewBig(id)).Error
+	return chain, err
+}
+
+// Chains returns all the additional chains, ordered by ID.
+func (orm *ORM) Chains() ([]models.Chain, error) {
+	orm.MustEnsureAdvisoryLock()
+	var chains []models.Chain
+	return chains, orm.db.Order("id asc").Find(&chains).Error
+}
+
+// DeleteChain removes a chain, leaving its heads and transactions in place.
+func (orm *ORM) DeleteChain(id *big.Int) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Delete(&models.Chain{}, "id = ?", utils.NewBig(id)).Error
+}
+
 // DeleteStaleSessions deletes all sessions before the passed time.
 func (orm *ORM) DeleteStaleSessions(before time.Time) error {
 	orm.MustEnsureAdvisoryLock()
@@ -1053,6 +1291,32 @@ func (orm *ORM) Keys() ([]*models.Key, error) {
 	return keys, orm.db.Find(&keys).Order("created_at ASC, address ASC").Error
 }
 
+// FindKey returns the key of the given address.
+func (orm *ORM) FindKey(address common.Address) (*models.Key, error) {
+	orm.MustEnsureAdvisoryLock()
+	var key models.Key
+	return &key, orm.db.Where("address = ?", address.Bytes()).First(&key).Error
+}
+
+// SaveKey updates the usage and state of a key.
+func (orm *ORM) SaveKey(k *models.Key) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(k).Updates(map[string]interface{}{
+		"usage":    k.Usage,
+		"disabled": k.Disabled,
+		"retiring": k.Retiring,
+	}).Error
+}
+
+// HasUnconfirmedTxs returns true if any transaction sent from the address is
+// not confirmed yet.
+func (orm *ORM) HasUnconfirmedTxs(from common.Address) (bool, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := orm.db.Model(&models.Tx{}).Where(`"from" = ? AND confirmed = ?`, from, false).Count(&count).Error
+	return count > 0, err
+}
+
 // DeleteKey deletes a key whose address matches the supplied bytes.
 func (orm *ORM) DeleteKey(address []byte) error {
 	return orm.db.Exec("DELETE FROM keys WHERE address = ?", address).Error
@@ -1137,6 +1401,44 @@ func (orm *ORM) CreateLogConsumption(lc *models.LogConsumption) error {
 	return orm.db.Create(lc).Error
 }
 
+// DeleteLogConsumptionsBefore deletes the log consumptions recorded before
+// the passed time.
+func (orm *ORM) DeleteLogConsumptionsBefore(before time.Time) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Where("created_at < ?", before).Delete(models.LogConsumption{}).Error
+}
+
+// CreateLogReplay saves a new log replay.
+func (orm *ORM) CreateLogReplay(replay *models.LogReplay) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Create(replay).Error
+}
+
+// SaveLogReplay updates the status and counts of a log replay.
+func (orm *ORM) SaveLogReplay(replay *models.LogReplay) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Save(replay).Error
+}
+
+// FindLogReplay looks up a log replay by its ID.
+func (orm *ORM) FindLogReplay(id int64) (models.LogReplay, error) {
+	orm.MustEnsureAdvisoryLock()
+	var replay models.LogReplay
+	return replay, orm.db.First(&replay, "id = ?", id).Error
+}
+
+// FailInProgressLogReplays marks the log replays that were running when the
+// node stopped as errored, since they are not resumed.
+func (orm *ORM) FailInProgressLogReplays() error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.db.Model(&models.LogReplay{}).
+		Where("status = ?", models.LogReplayStatusInProgress).
+		Updates(map[string]interface{}{
+			"status": models.LogReplayStatusErrored,
+			"error":  "the node stopped before the replay was done",
+		}).Error
+}
+
 // FindLogConsumer finds the consuming job of a particular LogConsumption record
 func (orm *ORM) FindLogConsumer(lc *models.LogConsumption) (models.JobSpec, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -134,6 +134,27 @@ func TestORM_ArchiveJob(t *testing.T) {
 	require.NoError(t, utils.JustError(orm.FindJobRun(run.ID)))
 }
 
+func TestORM_SetFluxMonitorPaused(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	job.Initiators = append(job.Initiators, models.Initiator{Type: models.InitiatorWeb})
+	require.NoError(t, store.CreateJob(&job))
+
+	require.NoError(t, store.SetFluxMonitorPaused(job.ID, true))
+	job, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.True(t, job.InitiatorsFor(models.InitiatorFluxMonitor)[0].Paused)
+	assert.False(t, job.InitiatorsFor(models.InitiatorWeb)[0].Paused)
+
+	require.NoError(t, store.SetFluxMonitorPaused(job.ID, false))
+	job, err = store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.False(t, job.InitiatorsFor(models.InitiatorFluxMonitor)[0].Paused)
+}
+
 func TestORM_CreateJobRun_CreatesRunRequest(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -1401,7 +1422,7 @@ func TestORM_UnconfirmedTxAttempts(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	attempts, err := store.ORM.UnconfirmedTxAttempts()
+	attempts, err := store.ORM.UnconfirmedTxAttempts(nil)
 	require.NoError(t, err)
 
 	assert.Len(t, attempts, 7)
@@ -1512,3 +1533,48 @@ func TestJobs_SQLiteBatchSizeIntegrity(t *testing.T) {
 
 	assert.Equal(t, jobNumber, counter)
 }
+
+func TestORM_FluxMonitorRoundsFor(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	run := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&run))
+	tx := cltest.CreateTx(t, store, cltest.NewAddress(), 1)
+	tx.SurrogateID = null.StringFrom(run.ID.String())
+	require.NoError(t, store.SaveTx(tx))
+
+	skipped := models.NewFluxMonitorRound(job.ID, 1, models.FluxMonitorTriggerPoll)
+	skipped.SkipReason = "deviation < threshold"
+	require.NoError(t, store.CreateFluxMonitorRound(skipped))
+	submitted := models.NewFluxMonitorRound(job.ID, 2, models.FluxMonitorTriggerNewRound)
+	submitted.Eligible = true
+	submitted.Submit(&run)
+	require.NoError(t, store.CreateFluxMonitorRound(submitted))
+	other := models.NewFluxMonitorRound(models.NewID(), 1, models.FluxMonitorTriggerIdle)
+	require.NoError(t, store.CreateFluxMonitorRound(other))
+
+	rounds, count, err := store.FluxMonitorRoundsFor(job.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, rounds, 2)
+
+	assert.Equal(t, uint32(2), rounds[0].RoundID)
+	assert.True(t, rounds[0].Submitted)
+	require.NotNil(t, rounds[0].TxHash)
+	assert.Equal(t, tx.Hash, *rounds[0].TxHash)
+
+	assert.Equal(t, uint32(1), rounds[1].RoundID)
+	assert.Equal(t, "deviation < threshold", rounds[1].SkipReason)
+	assert.Nil(t, rounds[1].TxHash)
+
+	rounds, count, err = store.FluxMonitorRoundsFor(job.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, rounds, 1)
+	assert.Equal(t, uint32(1), rounds[0].RoundID)
+}
@@ -15,6 +15,10 @@ import (
 // ConfigSchema records the schema of configuration at the type level
 type ConfigSchema struct {
 	AllowOrigins                    string          `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	BalanceMonitorAlertURL          *url.URL        `env:"BALANCE_MONITOR_ALERT_URL"`
+	BalanceMonitorEnabled           bool            `env:"BALANCE_MONITOR_ENABLED" default:"true"`
+	BalanceMonitorEthThreshold      big.Int         `env:"BALANCE_MONITOR_ETH_THRESHOLD" default:"100000000000000000"`
+	BalanceMonitorLinkThreshold     assets.Link     `env:"BALANCE_MONITOR_LINK_THRESHOLD" default:"0"`
 	BlockBackfillDepth              string          `env:"BLOCK_BACKFILL_DEPTH" default:"10"`
 	BridgeResponseURL               url.URL         `env:"BRIDGE_RESPONSE_URL"`
 	ChainID                         big.Int         `env:"ETH_CHAIN_ID" default:"1"`
@@ -27,16 +31,33 @@ type ConfigSchema struct {
 	EnableExperimentalAdapters      bool            `env:"ENABLE_EXPERIMENTAL_ADAPTERS" default:"false"`
 	FeatureExternalInitiators       bool            `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
 	FeatureFluxMonitor              bool            `env:"FEATURE_FLUX_MONITOR" default:"false"`
+	FluxMonitorLinkEthFeed          common.Address  `env:"FLUX_MONITOR_LINK_ETH_FEED"`
+	FluxMonitorRoundsRetention      models.Duration `env:"FLUX_MONITOR_ROUNDS_RETENTION" default:"720h"`
+	FluxMonitorSubmissionGas        uint64          `env:"FLUX_MONITOR_SUBMISSION_GAS" default:"200000"`
+	FluxMonitorWatchdogAlertURL     *url.URL        `env:"FLUX_MONITOR_WATCHDOG_ALERT_URL"`
 	MaximumServiceDuration          models.Duration `env:"MAXIMUM_SERVICE_DURATION" default:"8760h" `
 	MinimumServiceDuration          models.Duration `env:"MINIMUM_SERVICE_DURATION" default:"0s" `
 	EthGasBumpThreshold             uint64          `env:"ETH_GAS_BUMP_THRESHOLD" default:"12" `
 	EthGasBumpWei                   big.Int         `env:"ETH_GAS_BUMP_WEI" default:"5000000000"`
 	EthGasBumpPercent               uint16          `env:"ETH_GAS_BUMP_PERCENT" default:"10"`
 	EthGasLimitDefault              uint64          `env:"ETH_GAS_LIMIT_DEFAULT" default:"500000"`
+	EthGasLimitMax                  uint64          `env:"ETH_GAS_LIMIT_MAX" default:"8000000"`
+	EthGasLimitMultiplier           float64         `env:"ETH_GAS_LIMIT_MULTIPLIER" default:"1.25"`
+	EthGasEstimationEnabled         bool            `env:"ETH_GAS_ESTIMATION_ENABLED" default:"true"`
 	EthGasPriceDefault              big.Int         `env:"ETH_GAS_PRICE_DEFAULT" default:"20000000000"`
+	EthTxBatchWindow                models.Duration `env:"ETH_TX_BATCH_WINDOW" default:"0s"`
 	EthMaxGasPriceWei               uint64          `env:"ETH_MAX_GAS_PRICE_WEI" default:"500000000000"`
+	EthMinGasPriceWei               uint64          `env:"ETH_MIN_GAS_PRICE_WEI" default:"0"`
+	EthPollingInterval              models.Duration `env:"ETH_POLLING_INTERVAL" default:"5s"`
+	EthPollingMaxBlockRange         uint64          `env:"ETH_POLLING_MAX_BLOCK_RANGE" default:"1000"`
+	EthRemoteSignerURL              string          `env:"ETH_REMOTE_SIGNER_URL"`
+	EthRemoteSignerCheckInterval    models.Duration `env:"ETH_REMOTE_SIGNER_CHECK_INTERVAL" default:"30s"`
 	EthereumURL                     string          `env:"ETH_URL" default:"ws://localhost:8546"`
 	EthereumDisabled                bool            `env:"ETH_DISABLED" default:"false"`
+	GasEstimator                    string          `env:"GAS_ESTIMATOR"`
+	GasOracleMultiplier             float64         `env:"GAS_ORACLE_MULTIPLIER" default:"1000000000"`
+	GasOraclePath                   string          `env:"GAS_ORACLE_PATH" default:"fast"`
+	GasOracleURL                    *url.URL        `env:"GAS_ORACLE_URL"`
 	GasUpdaterBlockDelay            uint16          `env:"GAS_UPDATER_BLOCK_DELAY" default:"3"`
 	GasUpdaterBlockHistorySize      uint16          `env:"GAS_UPDATER_BLOCK_HISTORY_SIZE" default:"24"`
 	GasUpdaterTransactionPercentile uint16          `env:"GAS_UPDATER_TRANSACTION_PERCENTILE" default:"60"`
@@ -46,6 +67,7 @@ type ConfigSchema struct {
 	ExplorerURL                     *url.URL        `env:"EXPLORER_URL"`
 	ExplorerAccessKey               string          `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret                  string          `env:"EXPLORER_SECRET"`
+	LogConsumptionRetention         models.Duration `env:"LOG_CONSUMPTION_RETENTION" default:"720h"`
 	LogLevel                        LogLevel        `env:"LOG_LEVEL" default:"info"`
 	LogToDisk                       bool            `env:"LOG_TO_DISK" default:"true"`
 	LogSQLStatements                bool            `env:"LOG_SQL" default:"false"`
@@ -55,8 +77,10 @@ type ConfigSchema struct {
 	MinIncomingConfirmations        uint32          `env:"MIN_INCOMING_CONFIRMATIONS" default:"3"`
 	MinOutgoingConfirmations        uint64          `env:"MIN_OUTGOING_CONFIRMATIONS" default:"12"`
 	MinimumContractPayment          assets.Link     `env:"MINIMUM_CONTRACT_PAYMENT" default:"1000000000000000000"`
+	MulticallForwarderAddress       common.Address  `env:"MULTICALL_FORWARDER_ADDRESS"`
 	MinimumRequestExpiration        uint64          `env:"MINIMUM_REQUEST_EXPIRATION" default:"300"`
 	MaxRPCCallsPerSecond            uint64          `env:"MAX_RPC_CALLS_PER_SECOND" default:"500"`
+	MaxRPCBatchSize                 uint16          `env:"MAX_RPC_BATCH_SIZE" default:"100"`
 	OracleContractAddress           common.Address  `env:"ORACLE_CONTRACT_ADDRESS"`
 	Port                            uint16          `env:"CHAINLINK_PORT" default:"6688"`
 	ReaperExpiration                models.Duration `env:"REAPER_EXPIRATION" default:"240h"`
@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -97,20 +98,30 @@ type balanceable interface {
 }
 
 // AccountBalance holds the hex representation of the address plus it's ETH & LINK balances
+// on one chain. Balances on the node's additional chains set ChainID.
 type AccountBalance struct {
 	Address     string       `json:"address"`
+	ChainID     string       `json:"chainId,omitempty"`
 	EthBalance  *assets.Eth  `json:"ethBalance"`
 	LinkBalance *assets.Link `json:"linkBalance"`
 }
 
-// GetID returns the ID of this structure for jsonapi serialization.
+// GetID returns the ID of this structure for jsonapi serialization, which is
+// the address, followed by the chain ID on additional chains.
 func (a AccountBalance) GetID() string {
-	return a.Address
+	if a.ChainID == "" {
+		return a.Address
+	}
+	return a.Address + "/" + a.ChainID
 }
 
 // SetID is used to set the ID of this structure when deserializing from jsonapi documents.
 func (a *AccountBalance) SetID(value string) error {
-	a.Address = value
+	parts := strings.SplitN(value, "/", 2)
+	a.Address = parts[0]
+	if len(parts) == 2 {
+		a.ChainID = parts[1]
+	}
 	return nil
 }
 
@@ -163,17 +174,21 @@ type Whitelist struct {
 // NewConfigWhitelist creates an instance of ConfigWhitelist
 func NewConfigWhitelist(store *store.Store) (ConfigWhitelist, error) {
 	config := store.Config
-	account, err := store.KeyStore.GetFirstAccount()
+	account, err := store.ReceivingAccount()
 	if err != nil {
 		return ConfigWhitelist{}, err
 	}
+	accountAddress := ""
+	if account != nil {
+		accountAddress = account.Address.Hex()
+	}
 
 	explorerURL := ""
 	if config.ExplorerURL() != nil {
 		explorerURL = config.ExplorerURL().String()
 	}
 	return ConfigWhitelist{
-		AccountAddress: account.Address.Hex(),
+		AccountAddress: accountAddress,
 		Whitelist: Whitelist{
 			AllowOrigins:             config.AllowOrigins(),
 			BlockBackfillDepth:       config.BlockBackfillDepth(),
@@ -414,9 +429,13 @@ func (i Initiator) FriendlyAddress() string {
 	return ""
 }
 
-// JobRun presents an API friendly version of the data.
+// JobRun presents an API friendly version of the data. TxCancellation reports
+// whether the transaction of a cancelled run was replaced in time, and
+// TxCancellationError why it could not be.
 type JobRun struct {
 	models.JobRun
+	TxCancellation      models.TxCancellation `json:"txCancellation,omitempty"`
+	TxCancellationError string                `json:"txCancellationError,omitempty"`
 }
 
 // MarshalJSON returns the JSON data of the JobRun and its Initiator.
@@ -565,8 +584,45 @@ func (a NewAccount) GetName() string {
 	return "keys"
 }
 
+// ETHKey holds the address and lifecycle of an ethereum key.
+type ETHKey struct {
+	Address   string          `json:"address"`
+	Usage     models.KeyUsage `json:"usage"`
+	Disabled  bool            `json:"disabled"`
+	Retiring  bool            `json:"retiring"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// NewETHKey returns the presenter of key.
+func NewETHKey(key *models.Key) ETHKey {
+	return ETHKey{
+		Address:   key.Address.String(),
+		Usage:     key.Usage,
+		Disabled:  key.Disabled,
+		Retiring:  key.Retiring,
+		CreatedAt: key.CreatedAt,
+	}
+}
+
+// GetID returns the jsonapi ID.
+func (k ETHKey) GetID() string {
+	return k.Address
+}
+
+// GetName returns the collection name for jsonapi.
+func (k ETHKey) GetName() string {
+	return "keys"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (k *ETHKey) SetID(value string) error {
+	k.Address = value
+	return nil
+}
+
 // Tx is a jsonapi wrapper for an Ethereum Transaction.
 type Tx struct {
+	ChainID   string          `json:"chainId,omitempty"`
 	Confirmed bool            `json:"confirmed,omitempty"`
 	Data      hexutil.Bytes   `json:"data,omitempty"`
 	From      *common.Address `json:"from,omitempty"`
@@ -582,7 +638,12 @@ type Tx struct {
 
 // NewTx builds a transaction presenter.
 func NewTx(tx *models.Tx) Tx {
+	var chainID string
+	if tx.ChainID != nil {
+		chainID = tx.ChainID.String()
+	}
 	return Tx{
+		ChainID:   chainID,
 		Confirmed: tx.Confirmed,
 		Data:      hexutil.Bytes(tx.Data),
 		From:      &tx.From,
@@ -696,3 +757,54 @@ func NewExplorerStatus(statsPusher synchronization.StatsPusher) ExplorerStatus {
 		Url:    url.String(),
 	}
 }
+
+// Chain is a jsonapi wrapper for a chain known to the node, along with
+// whether it is currently served and connected, and its latest head.
+type Chain struct {
+	models.Chain
+	Default   bool       `json:"default"`
+	Serving   bool       `json:"serving"`
+	Connected bool       `json:"connected"`
+	Head      *utils.Big `json:"head,omitempty"`
+}
+
+// NewChains returns the default chain followed by every chain saved in the
+// database.
+func NewChains(store *store.Store) ([]Chain, error) {
+	defaultChain := models.NewChain(store.Config.ChainID(), store.Config.EthereumURL())
+	records, err := store.ORM.Chains()
+	if err != nil {
+		return nil, err
+	}
+
+	var merr error
+	chains := []Chain{}
+	for _, record := range append([]models.Chain{defaultChain}, records...) {
+		chain, err := NewChain(store, record)
+		merr = multierr.Append(merr, err)
+		chains = append(chains, chain)
+	}
+	return chains, merr
+}
+
+// NewChain builds a chain presenter. A chain created since the node started
+// is not served until the node restarts.
+func NewChain(store *store.Store, record models.Chain) (Chain, error) {
+	presenter := Chain{Chain: record}
+	chain, err := store.Chain(record.ID.ToInt())
+	if err != nil {
+		return presenter, nil
+	}
+	presenter.Default = chain.IsDefault(store.Config)
+	presenter.Serving = true
+	presenter.Connected = chain.TxManager.Connected()
+
+	head, err := store.LastHead(chain.ID)
+	if err != nil {
+		return presenter, err
+	}
+	if head != nil {
+		presenter.Head = utils.NewBig(head.ToInt())
+	}
+	return presenter, nil
+}
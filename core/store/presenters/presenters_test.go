@@ -35,3 +35,27 @@ func TestTx_MarshalJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, string(b))
 }
+
+func TestAccountBalance_ID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		balance AccountBalance
+		id      string
+	}{
+		{"default chain", AccountBalance{Address: "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea"}, "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea"},
+		{"additional chain", AccountBalance{Address: "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea", ChainID: "42"}, "0x3cb8e3FD9d27e39a5e9e6852b0e96160061fd4ea/42"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.id, test.balance.GetID())
+
+			var balance AccountBalance
+			assert.NoError(t, balance.SetID(test.id))
+			assert.Equal(t, test.balance, balance)
+		})
+	}
+}
@@ -20,10 +20,29 @@ var promETHBalance = promauto.NewGaugeVec(
 		Name: "eth_balance",
 		Help: "Each Ethereum account's balance",
 	},
-	[]string{"account"},
+	[]string{"account", "chain_id"},
 )
 
-func promUpdateEthBalance(balance *assets.Eth, from common.Address) {
+var promLINKBalance = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "link_balance",
+		Help: "Each Ethereum account's LINK balance",
+	},
+	[]string{"account", "chain_id"},
+)
+
+// PromUpdateBalances records the ETH and LINK balances of an account on the
+// given chain.
+func PromUpdateBalances(chainID *big.Int, account common.Address, ethBalance *assets.Eth, linkBalance *assets.Link) {
+	promUpdateEthBalance(chainID, ethBalance, account)
+	linkFloat, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(linkBalance.ToInt()),
+		new(big.Float).SetInt(eth.WeiPerEth),
+	).Float64()
+	promLINKBalance.WithLabelValues(account.Hex(), chainID.String()).Set(linkFloat)
+}
+
+func promUpdateEthBalance(chainID *big.Int, balance *assets.Eth, from common.Address) {
 	balanceFloat, err := approximateFloat64(balance)
 
 	if err != nil {
@@ -31,7 +50,7 @@ func promUpdateEthBalance(balance *assets.Eth, from common.Address) {
 		return
 	}
 
-	promETHBalance.WithLabelValues(from.Hex()).Set(balanceFloat)
+	promETHBalance.WithLabelValues(from.Hex(), chainID.String()).Set(balanceFloat)
 }
 
 func approximateFloat64(e *assets.Eth) (float64, error) {
@@ -0,0 +1,293 @@
+package store
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// signerCaller is the part of an RPC client used to talk to a remote signer.
+type signerCaller interface {
+	Call(result interface{}, method string, args ...interface{}) error
+}
+
+// RemoteKeyStore implements KeyStoreInterface by delegating signing to an
+// external signer speaking the JSON-RPC API of geth's Clef, so that the
+// private keys never live on the node host. The accounts are those listed by
+// the signer, which are discovered again on every health check.
+type RemoteKeyStore struct {
+	signer   signerCaller
+	mutex    sync.RWMutex
+	accounts []accounts.Account
+	chStop   chan struct{}
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+}
+
+// NewRemoteKeyStore returns a key store delegating to the signer reachable
+// through the given RPC client.
+func NewRemoteKeyStore(signer signerCaller) *RemoteKeyStore {
+	return &RemoteKeyStore{
+		signer: signer,
+		chStop: make(chan struct{}),
+	}
+}
+
+// DialRemoteKeyStore connects to the signer at rawurl, an HTTP, websocket or
+// IPC endpoint, checks that it is healthy and discovers its accounts.
+func DialRemoteKeyStore(rawurl string) (*RemoteKeyStore, error) {
+	client, err := rpc.Dial(rawurl)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to dial remote signer")
+	}
+	ks := NewRemoteKeyStore(client)
+	if err := ks.HealthCheck(); err != nil {
+		return nil, err
+	}
+	return ks, ks.Discover()
+}
+
+// HealthCheck returns an error if the signer does not answer.
+func (ks *RemoteKeyStore) HealthCheck() error {
+	var version string
+	if err := ks.signer.Call(&version, "account_version"); err != nil {
+		return errors.Wrap(err, "remote signer is unhealthy")
+	}
+	return nil
+}
+
+// Discover replaces the accounts with those currently held by the signer.
+func (ks *RemoteKeyStore) Discover() error {
+	_, err := ks.discover()
+	return err
+}
+
+// discover returns the accounts that were not known before.
+func (ks *RemoteKeyStore) discover() ([]accounts.Account, error) {
+	var addresses []common.Address
+	if err := ks.signer.Call(&addresses, "account_list"); err != nil {
+		return nil, errors.Wrap(err, "unable to list remote signer accounts")
+	}
+
+	ks.mutex.Lock()
+	defer ks.mutex.Unlock()
+	known := make(map[common.Address]bool, len(ks.accounts))
+	for _, account := range ks.accounts {
+		known[account.Address] = true
+	}
+	var discovered []accounts.Account
+	ks.accounts = make([]accounts.Account, len(addresses))
+	for i, address := range addresses {
+		ks.accounts[i] = accounts.Account{Address: address}
+		if !known[address] {
+			discovered = append(discovered, ks.accounts[i])
+		}
+	}
+	return discovered, nil
+}
+
+// Start checks the health of the signer and discovers its accounts every
+// interval until Stop is called, calling onDiscover with all the accounts
+// whenever a new one appears.
+func (ks *RemoteKeyStore) Start(interval time.Duration, onDiscover func([]accounts.Account)) {
+	ks.wg.Add(1)
+	go func() {
+		defer ks.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ks.chStop:
+				return
+			case <-ticker.C:
+			}
+
+			if err := ks.HealthCheck(); err != nil {
+				logger.Errorw("Remote signer health check failed", "error", err)
+				continue
+			}
+			discovered, err := ks.discover()
+			if err != nil {
+				logger.Errorw("Remote signer key discovery failed", "error", err)
+				continue
+			}
+			for _, account := range discovered {
+				logger.Infow("Discovered remote signer account "+account.Address.Hex(), "address", account.Address.Hex())
+			}
+			if len(discovered) > 0 {
+				onDiscover(ks.Accounts())
+			}
+		}
+	}()
+}
+
+// Stop ends the health checks.
+func (ks *RemoteKeyStore) Stop() {
+	ks.stopOnce.Do(func() {
+		close(ks.chStop)
+	})
+	ks.wg.Wait()
+}
+
+// Accounts returns the accounts held by the signer.
+func (ks *RemoteKeyStore) Accounts() []accounts.Account {
+	ks.mutex.RLock()
+	defer ks.mutex.RUnlock()
+	cp := make([]accounts.Account, len(ks.accounts))
+	copy(cp, ks.accounts)
+	return cp
+}
+
+// GetAccounts returns all accounts
+func (ks *RemoteKeyStore) GetAccounts() []accounts.Account {
+	return ks.Accounts()
+}
+
+// Wallets returns no wallets, the keys are only reachable through the signer.
+func (ks *RemoteKeyStore) Wallets() []accounts.Wallet {
+	return nil
+}
+
+// HasAccounts returns true if the signer holds any account.
+func (ks *RemoteKeyStore) HasAccounts() bool {
+	return len(ks.Accounts()) > 0
+}
+
+// GetFirstAccount returns the first account held by the signer.
+func (ks *RemoteKeyStore) GetFirstAccount() (accounts.Account, error) {
+	accts := ks.Accounts()
+	if len(accts) == 0 {
+		return accounts.Account{}, errors.New("no Ethereum Accounts configured")
+	}
+	return accts[0], nil
+}
+
+// Unlock ignores the password, which is only known to the signer, and
+// discovers its accounts.
+func (ks *RemoteKeyStore) Unlock(string) error {
+	if err := ks.HealthCheck(); err != nil {
+		return err
+	}
+	return ks.Discover()
+}
+
+// NewAccount asks the signer to create an account, which its operator may
+// have to approve. The passphrase is ignored.
+func (ks *RemoteKeyStore) NewAccount(string) (accounts.Account, error) {
+	var address common.Address
+	if err := ks.signer.Call(&address, "account_new"); err != nil {
+		return accounts.Account{}, errors.Wrap(err, "unable to create remote signer account")
+	}
+	if err := ks.Discover(); err != nil {
+		return accounts.Account{}, err
+	}
+	return accounts.Account{Address: address}, nil
+}
+
+// Import is not supported, keys have to be imported into the signer itself.
+func (ks *RemoteKeyStore) Import([]byte, string, string) (accounts.Account, error) {
+	return accounts.Account{}, errors.New("cannot import keys through a remote signer, import them into the signer instead")
+}
+
+// Export is not supported, the keys never leave the signer.
+func (ks *RemoteKeyStore) Export(accounts.Account, string, string) ([]byte, error) {
+	return nil, errors.New("cannot export keys from a remote signer")
+}
+
+// Delete is not supported, keys have to be deleted from the signer itself.
+func (ks *RemoteKeyStore) Delete(accounts.Account, string) error {
+	return errors.New("cannot delete keys through a remote signer, delete them from the signer instead")
+}
+
+// signerTxArgs are the transaction fields sent to account_signTransaction.
+type signerTxArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice hexutil.Big     `json:"gasPrice"`
+	Value    hexutil.Big     `json:"value"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	Data     *hexutil.Bytes  `json:"data"`
+	ChainID  *hexutil.Big    `json:"chainId,omitempty"`
+}
+
+type signerTxResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// SignTx asks the signer to sign the transaction with the account, checking
+// that the signer neither altered it nor signed it for another chain.
+func (ks *RemoteKeyStore) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	args := signerTxArgs{
+		From:     account.Address,
+		Gas:      hexutil.Uint64(tx.Gas()),
+		GasPrice: hexutil.Big(*tx.GasPrice()),
+		Value:    hexutil.Big(*tx.Value()),
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		To:       tx.To(),
+		Data:     &data,
+		ChainID:  (*hexutil.Big)(chainID),
+	}
+
+	var result signerTxResult
+	if err := ks.signer.Call(&result, "account_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "remote signer did not sign transaction")
+	}
+	signed := new(types.Transaction)
+	if err := rlp.DecodeBytes(result.Raw, signed); err != nil {
+		return nil, errors.Wrap(err, "unable to decode transaction signed by remote signer")
+	}
+
+	signer := types.NewEIP155Signer(chainID)
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote signer")
+	}
+	if sender != account.Address {
+		return nil, fmt.Errorf("remote signer signed transaction with %s instead of %s", sender.Hex(), account.Address.Hex())
+	}
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer signed a different transaction")
+	}
+	return signed, nil
+}
+
+// SignHash asks the signer to sign the hash with the first account. Like
+// KeyStore.SignHash, the signed message is prefixed with
+// EthereumMessageHashPrefix, which the signer adds to text/plain data.
+func (ks *RemoteKeyStore) SignHash(hash common.Hash) (models.Signature, error) {
+	account, err := ks.GetFirstAccount()
+	if err != nil {
+		return models.Signature{}, err
+	}
+
+	var output hexutil.Bytes
+	err = ks.signer.Call(&output, "account_signData", "text/plain", account.Address, hexutil.Bytes(hash.Bytes()))
+	if err != nil {
+		return models.Signature{}, errors.Wrap(err, "remote signer did not sign hash")
+	}
+	if len(output) != len(models.Signature{}) {
+		return models.Signature{}, fmt.Errorf("remote signer returned a signature of %d bytes", len(output))
+	}
+	// Clef returns a legacy V of 27 or 28, go-ethereum's keystore 0 or 1
+	if output[64] >= 27 {
+		output[64] -= 27
+	}
+
+	var signature models.Signature
+	signature.SetBytes(output)
+	return signature, nil
+}
@@ -0,0 +1,104 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteKeyStore_DiscoversAccounts(t *testing.T) {
+	t.Parallel()
+
+	signer, cleanup := cltest.NewRemoteSigner(t)
+	defer cleanup()
+
+	ks, err := strpkg.DialRemoteKeyStore(signer.URL)
+	require.NoError(t, err)
+	require.True(t, ks.HasAccounts())
+	account, err := ks.GetFirstAccount()
+	require.NoError(t, err)
+	assert.Equal(t, signer.Addresses()[0], account.Address)
+
+	signer.AddKey(t)
+	require.Len(t, ks.Accounts(), 1)
+	require.NoError(t, ks.Unlock(""))
+	assert.Len(t, ks.Accounts(), 2)
+
+	created, err := ks.NewAccount("")
+	require.NoError(t, err)
+	assert.Len(t, ks.Accounts(), 3)
+	assert.Equal(t, signer.Addresses()[2], created.Address)
+}
+
+func TestRemoteKeyStore_SignTx(t *testing.T) {
+	t.Parallel()
+
+	signer, cleanup := cltest.NewRemoteSigner(t)
+	defer cleanup()
+
+	ks, err := strpkg.DialRemoteKeyStore(signer.URL)
+	require.NoError(t, err)
+	account, err := ks.GetFirstAccount()
+	require.NoError(t, err)
+
+	chainID := big.NewInt(42)
+	tx := types.NewTransaction(7, cltest.NewAddress(), big.NewInt(1), 21000, big.NewInt(20000000000), []byte{1, 2, 3})
+	signed, err := ks.SignTx(account, tx, chainID)
+	require.NoError(t, err)
+
+	sender, err := types.Sender(types.NewEIP155Signer(chainID), signed)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address, sender)
+	assert.Equal(t, tx.Nonce(), signed.Nonce())
+	assert.Equal(t, tx.Data(), signed.Data())
+
+	account.Address = cltest.NewAddress()
+	_, err = ks.SignTx(account, tx, chainID)
+	assert.Error(t, err)
+}
+
+func TestRemoteKeyStore_SignHash(t *testing.T) {
+	t.Parallel()
+
+	signer, cleanup := cltest.NewRemoteSigner(t)
+	defer cleanup()
+
+	ks, err := strpkg.DialRemoteKeyStore(signer.URL)
+	require.NoError(t, err)
+
+	hash := cltest.NewHash()
+	signature, err := ks.SignHash(hash)
+	require.NoError(t, err)
+
+	prefixed, err := utils.Keccak256(append([]byte(strpkg.EthereumMessageHashPrefix), hash.Bytes()...))
+	require.NoError(t, err)
+	pubKey, err := crypto.SigToPub(prefixed, signature[:])
+	require.NoError(t, err)
+	assert.Equal(t, signer.Addresses()[0], crypto.PubkeyToAddress(*pubKey))
+}
+
+func TestRemoteKeyStore_Unhealthy(t *testing.T) {
+	t.Parallel()
+
+	signer, cleanup := cltest.NewRemoteSigner(t)
+	ks, err := strpkg.DialRemoteKeyStore(signer.URL)
+	require.NoError(t, err)
+	assert.NoError(t, ks.HealthCheck())
+
+	cleanup()
+	assert.Error(t, ks.HealthCheck())
+	assert.Error(t, ks.Unlock(""))
+	_, err = strpkg.DialRemoteKeyStore(signer.URL)
+	assert.Error(t, err)
+
+	_, err = ks.Import([]byte("{}"), cltest.Password, cltest.Password)
+	assert.Error(t, err)
+}
@@ -39,7 +39,11 @@ type Store struct {
 	KeyStore    KeyStoreInterface
 	VRFKeyStore *VRFKeyStore
 	TxManager   TxManager
+	chains      []Chain
 	closeOnce   *sync.Once
+
+	gasEstimatorsMutex *sync.Mutex
+	gasEstimators      map[string]GasEstimator
 }
 
 type lazyRPCWrapper struct {
@@ -50,13 +54,13 @@ type lazyRPCWrapper struct {
 	limiter     *rate.Limiter
 }
 
-func newLazyRPCWrapper(urlString string, limiter *rate.Limiter) (eth.CallerSubscriber, error) {
+func newLazyRPCWrapper(urlString string, limiter *rate.Limiter) (*lazyRPCWrapper, error) {
 	parsed, err := url.ParseRequestURI(urlString)
 	if err != nil {
 		return nil, err
 	}
-	if parsed.Scheme != "ws" && parsed.Scheme != "wss" {
-		return nil, fmt.Errorf("ethereum url scheme must be websocket: %s", parsed.String())
+	if !isWebsocketURL(parsed) && !isHTTPURL(parsed) {
+		return nil, fmt.Errorf("ethereum url scheme must be websocket or http: %s", parsed.String())
 	}
 	return &lazyRPCWrapper{
 		url:         parsed,
@@ -101,6 +105,21 @@ func (wrapper *lazyRPCWrapper) Call(result interface{}, method string, args ...i
 	return wrapper.client.Call(result, method, args...)
 }
 
+// BatchCall sends the given calls to the ethereum node in a single JSON-RPC
+// batch request, which counts once against the rate limit.
+func (wrapper *lazyRPCWrapper) BatchCall(b []rpc.BatchElem) error {
+	err := wrapper.lazyDialInitializer()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	wrapper.limiter.Wait(ctx)
+
+	return wrapper.client.BatchCallContext(ctx, b)
+}
+
 func (wrapper *lazyRPCWrapper) Subscribe(ctx context.Context, channel interface{}, args ...interface{}) (eth.Subscription, error) {
 	err := wrapper.lazyDialInitializer()
 	if err != nil {
@@ -114,6 +133,14 @@ func (wrapper *lazyRPCWrapper) Subscribe(ctx context.Context, channel interface{
 	return wrapper.client.EthSubscribe(ctx, channel, args...)
 }
 
+func isWebsocketURL(u *url.URL) bool {
+	return u.Scheme == "ws" || u.Scheme == "wss"
+}
+
+func isHTTPURL(u *url.URL) bool {
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
 // Dialer implements Dial which is a function that creates a client for that url
 type Dialer interface {
 	Dial(string) (eth.CallerSubscriber, error)
@@ -121,25 +148,45 @@ type Dialer interface {
 
 // EthDialer is Dialer which accesses rpc urls
 type EthDialer struct {
-	limiter *rate.Limiter
+	limiter         *rate.Limiter
+	maxBatchSize    int
+	pollingInterval time.Duration
+	maxBlockRange   uint64
 }
 
-// NewEthDialer returns an eth dialer with the specified rate limit
-func NewEthDialer(rateLimit uint64) *EthDialer {
+// NewEthDialer returns an eth dialer with the rate limit, batch size and
+// polling settings of the given config, batching concurrent calls into
+// JSON-RPC batch requests
+func NewEthDialer(config *orm.Config) *EthDialer {
 	return &EthDialer{
-		limiter: rate.NewLimiter(rate.Limit(rateLimit), 1),
+		limiter:         rate.NewLimiter(rate.Limit(config.MaxRPCCallsPerSecond()), 1),
+		maxBatchSize:    int(config.MaxRPCBatchSize()),
+		pollingInterval: config.EthPollingInterval().Duration(),
+		maxBlockRange:   config.EthPollingMaxBlockRange(),
 	}
 }
 
-// Dial will dial the given url and return a CallerSubscriber
+// Dial will dial the given url and return a CallerSubscriber. Subscriptions
+// made over an HTTP url are emulated by polling.
 func (ed *EthDialer) Dial(urlString string) (eth.CallerSubscriber, error) {
-	return newLazyRPCWrapper(urlString, ed.limiter)
+	wrapper, err := newLazyRPCWrapper(urlString, ed.limiter)
+	if err != nil {
+		return nil, err
+	}
+	cs := eth.NewBatchingCallerSubscriber(wrapper, ed.maxBatchSize)
+	if isHTTPURL(wrapper.url) {
+		cs = eth.NewPollingCallerSubscriber(cs, ed.pollingInterval, ed.maxBlockRange)
+	}
+	return cs, nil
 }
 
 // NewStore will create a new store using the Eth dialer
 func NewStore(config *orm.Config, shutdownSignal gracefulpanic.Signal) *Store {
-	keyStore := func() *KeyStore { return NewKeyStore(config.KeysDir()) }
-	dialer := NewEthDialer(config.MaxRPCCallsPerSecond())
+	keyStore := func() KeyStoreInterface { return NewKeyStore(config.KeysDir()) }
+	if config.EthRemoteSignerURL() != "" {
+		keyStore = func() KeyStoreInterface { return dialRemoteKeyStore(config) }
+	}
+	dialer := NewEthDialer(config)
 	return newStoreWithDialerAndKeyStore(config, dialer, keyStore, shutdownSignal)
 }
 
@@ -147,15 +194,23 @@ func NewStore(config *orm.Config, shutdownSignal gracefulpanic.Signal) *Store {
 // dialer, using an insecure keystore.
 // NOTE: Should only be used for testing!
 func NewInsecureStore(config *orm.Config, shutdownSignal gracefulpanic.Signal) *Store {
-	dialer := NewEthDialer(config.MaxRPCCallsPerSecond())
-	keyStore := func() *KeyStore { return NewInsecureKeyStore(config.KeysDir()) }
+	dialer := NewEthDialer(config)
+	keyStore := func() KeyStoreInterface { return NewInsecureKeyStore(config.KeysDir()) }
 	return newStoreWithDialerAndKeyStore(config, dialer, keyStore, shutdownSignal)
 }
 
+func dialRemoteKeyStore(config *orm.Config) KeyStoreInterface {
+	keyStore, err := DialRemoteKeyStore(config.EthRemoteSignerURL())
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Unable to connect to remote signer: %+v", err))
+	}
+	return keyStore
+}
+
 func newStoreWithDialerAndKeyStore(
 	config *orm.Config,
 	dialer Dialer,
-	keyStoreGenerator func() *KeyStore,
+	keyStoreGenerator func() KeyStoreInterface,
 	shutdownSignal gracefulpanic.Signal,
 ) *Store {
 
@@ -174,32 +229,59 @@ func newStoreWithDialerAndKeyStore(
 	if err := orm.ClobberDiskKeyStoreWithDBKeys(config.KeysDir()); err != nil {
 		logger.Fatal(fmt.Sprintf("Unable to migrate key store to disk: %+v", err))
 	}
+	if err := orm.AssignDefaultChainID(config.ChainID()); err != nil {
+		logger.Fatal(fmt.Sprintf("Unable to assign default chain ID: %+v", err))
+	}
+	if err := orm.FailUnsentTxBatchCalls(); err != nil {
+		logger.Fatal(fmt.Sprintf("Unable to fail unsent batched calls: %+v", err))
+	}
+	if err := orm.FailInProgressLogReplays(); err != nil {
+		logger.Fatal(fmt.Sprintf("Unable to fail interrupted log replays: %+v", err))
+	}
 
 	keyStore := keyStoreGenerator()
 	callerSubscriberClient := &eth.CallerSubscriberClient{CallerSubscriber: ethrpc}
 	txManager := NewEthTxManager(callerSubscriberClient, config, keyStore, orm)
+	chains, err := dialChains(config, dialer, keyStore, orm)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Unable to dial chains: %+v", err))
+	}
 	store := &Store{
 		Clock:     utils.Clock{},
 		Config:    config,
 		KeyStore:  keyStore,
 		ORM:       orm,
 		TxManager: txManager,
+		chains:    chains,
 		closeOnce: &sync.Once{},
+
+		gasEstimatorsMutex: &sync.Mutex{},
+		gasEstimators:      make(map[string]GasEstimator),
 	}
 	store.VRFKeyStore = NewVRFKeyStore(store)
 	return store
 }
 
-// Start initiates all of Store's dependencies including the TxManager.
+// Start initiates all of Store's dependencies including the TxManager of
+// every chain.
 func (s *Store) Start() error {
-	s.TxManager.Register(s.KeyStore.Accounts())
-	return s.SyncDiskKeyStoreToDB()
+	if remote, ok := s.KeyStore.(*RemoteKeyStore); ok {
+		s.registerAccounts(s.KeyStore.Accounts())
+		remote.Start(s.Config.EthRemoteSignerCheckInterval().Duration(), s.registerAccounts)
+		return nil
+	}
+	err := s.SyncDiskKeyStoreToDB()
+	s.registerAccounts(s.KeyStore.Accounts())
+	return err
 }
 
 // Close shuts down all of the working parts of the store.
 func (s *Store) Close() error {
 	var err error
 	s.closeOnce.Do(func() {
+		if remote, ok := s.KeyStore.(*RemoteKeyStore); ok {
+			remote.Stop()
+		}
 		err = s.ORM.Close()
 	})
 	return err
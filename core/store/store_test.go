@@ -27,6 +27,7 @@ func TestStore_Start(t *testing.T) {
 
 	txManager := new(mocks.TxManager)
 	txManager.On("Register", mock.Anything).Return(big.NewInt(3), nil)
+	txManager.On("Retire", mock.Anything)
 	store.TxManager = txManager
 
 	assert.NoError(t, store.Start())
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v3"
+)
+
+// maxBatchedCalls caps the number of calls bundled into a single forwarded
+// transaction, keeping its gas limit well below the block gas limit.
+const maxBatchedCalls = 20
+
+// txBatch collects the calls to a contract that will be sent together
+// through the multicall forwarder.
+type txBatch struct {
+	to       common.Address
+	calls    [][]byte
+	callIDs  []uint64
+	gasLimit uint64
+	send     sync.Once
+}
+
+// BatchingEnabled returns true if the calls passed to CreateBatchedTx are
+// bundled into a single transaction.
+func (txm *EthTxManager) BatchingEnabled() bool {
+	return txm.config.EthTxBatchWindow().Duration() > 0 &&
+		txm.config.MulticallForwarderAddress() != nil
+}
+
+// CreateBatchedTx queues a call of the job run to the given contract,
+// bundling it with the other calls to the same contract made within
+// ETH_TX_BATCH_WINDOW into a single transaction sent through the multicall
+// forwarder. It returns the index of the call within the batch right away;
+// the TxBatchCall of the run is linked to the transaction once the batch is
+// sent.
+func (txm *EthTxManager) CreateBatchedTx(jobRunID *models.ID, to common.Address, data []byte, gasLimit uint64) (int, error) {
+	if !txm.BatchingEnabled() {
+		return 0, errors.New("transaction batching is disabled")
+	}
+	// The sum of the calls' gas limits is only used if the batch's gas limit
+	// cannot be estimated
+	_, gasLimit = normalizeGasParams(nil, gasLimit, txm.config)
+
+	txm.batchesMutex.Lock()
+	defer txm.batchesMutex.Unlock()
+
+	batch, ok := txm.pendingBatches[to]
+	if !ok {
+		batch = &txBatch{to: to}
+		txm.pendingBatches[to] = batch
+		time.AfterFunc(txm.config.EthTxBatchWindow().Duration(), func() {
+			txm.sendBatch(batch)
+		})
+	}
+
+	call := models.TxBatchCall{JobRunID: jobRunID, CallIndex: len(batch.calls)}
+	if err := txm.orm.CreateTxBatchCall(&call); err != nil {
+		return 0, errors.Wrap(err, "while recording batched call")
+	}
+	batch.calls = append(batch.calls, data)
+	batch.callIDs = append(batch.callIDs, call.ID)
+	batch.gasLimit += gasLimit
+	if len(batch.calls) >= maxBatchedCalls {
+		delete(txm.pendingBatches, to)
+		go txm.sendBatch(batch)
+	}
+	return call.CallIndex, nil
+}
+
+// sendBatch sends the batch once, either when its window elapses or when it
+// is full, whichever comes first.
+func (txm *EthTxManager) sendBatch(batch *txBatch) {
+	batch.send.Do(func() {
+		txm.batchesMutex.Lock()
+		if txm.pendingBatches[batch.to] == batch {
+			delete(txm.pendingBatches, batch.to)
+		}
+		txm.batchesMutex.Unlock()
+
+		tx, err := txm.createForwardedTx(batch.to, batch.calls, batch.gasLimit)
+		if err != nil {
+			logger.Errorw("Failed to send batched transaction", "to", batch.to.Hex(), "calls", len(batch.calls), "error", err)
+			err = txm.orm.MarkTxBatchCallsFailed(batch.callIDs, err.Error())
+		} else {
+			logger.Debugw("Sent batched transaction", "to", batch.to.Hex(), "calls", len(batch.calls), "txHash", tx.Hash.Hex())
+			err = txm.orm.MarkTxBatchCallsSent(batch.callIDs, tx.ID)
+		}
+		if err != nil {
+			logger.Errorw("Failed to record the outcome of batched calls", "to", batch.to.Hex(), "error", err)
+		}
+	})
+}
+
+func (txm *EthTxManager) createForwardedTx(to common.Address, calls [][]byte, gasLimit uint64) (*models.Tx, error) {
+	forwarder := txm.config.MulticallForwarderAddress()
+	if forwarder == nil {
+		return nil, errors.New("MulticallForwarderAddress not set; cannot send batched transaction")
+	}
+
+	data, err := eth.EncodeMulticallForward(to, calls)
+	if err != nil {
+		return nil, errors.Wrap(err, "while encoding batched calls")
+	}
+
+	ma, err := txm.nextAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err = txm.estimateGasLimit(ma.Address, *forwarder, data, nil, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return txm.createTx(null.String{}, ma, *forwarder, data, txm.config.EthGasPriceDefault(), gasLimit, nil)
+}
@@ -0,0 +1,28 @@
+package store
+
+import (
+	"go.uber.org/multierr"
+)
+
+// TrackTxCancellations bumps the gas of the transactions being cancelled on
+// every chain until either them or their no-op replacement is safe, recording
+// the outcome on the Tx. The runs that sent them no longer do so.
+func (s *Store) TrackTxCancellations() error {
+	var merr error
+	for _, chain := range s.Chains() {
+		if !chain.TxManager.Connected() {
+			continue
+		}
+		txs, err := s.PendingTxCancellations(chain.ID)
+		if err != nil {
+			merr = multierr.Append(merr, err)
+			continue
+		}
+		for _, tx := range txs {
+			if _, _, err := chain.TxManager.BumpGasUntilSafe(tx.Hash); err != nil {
+				merr = multierr.Append(merr, err)
+			}
+		}
+	}
+	return merr
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tevino/abool"
@@ -39,6 +41,10 @@ var (
 	// ErrPendingConnection is the error returned if TxManager is not connected.
 	ErrPendingConnection = errors.New("Cannot talk to chain, pending connection")
 
+	// ErrTxWouldRevert is the error returned if the ethereum node expects a
+	// transaction to revert when estimating its gas.
+	ErrTxWouldRevert = errors.New("transaction would revert")
+
 	promNumGasBumps = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "tx_manager_num_gas_bumps",
 		Help: "Number of gas bumps",
@@ -67,13 +73,18 @@ type TxManager interface {
 	HeadTrackable
 	Connected() bool
 	Register(accounts []accounts.Account)
+	Retire(accounts []accounts.Account)
 
 	CreateTx(to common.Address, data []byte) (*models.Tx, error)
 	CreateTxWithGas(surrogateID null.String, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error)
+	CreateTxWithGasFrom(surrogateID null.String, from, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error)
 	CreateTxWithEth(from, to common.Address, value *assets.Eth) (*models.Tx, error)
+	BatchingEnabled() bool
+	CreateBatchedTx(jobRunID *models.ID, to common.Address, data []byte, gasLimit uint64) (int, error)
 	CheckAttempt(txAttempt *models.TxAttempt, blockHeight uint64) (*eth.TxReceipt, AttemptState, error)
 
 	BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, AttemptState, error)
+	CancelTx(tx *models.Tx) error
 
 	ContractLINKBalance(wr models.WithdrawalRequest) (assets.Link, error)
 	WithdrawLINK(wr models.WithdrawalRequest) (common.Hash, error)
@@ -95,33 +106,99 @@ type EthTxManager struct {
 	registeredAccounts  []accounts.Account
 	availableAccounts   []*ManagedAccount
 	availableAccountIdx int
+	registrations       uint64
+	retiringAccounts    map[common.Address]bool
 	accountsMutex       *sync.Mutex
 	connected           *abool.AtomicBool
 	currentHead         models.Head
+	batchesMutex        *sync.Mutex
+	pendingBatches      map[common.Address]*txBatch
+	gasEstimatesMutex   *sync.Mutex
+	gasEstimates        map[gasEstimateKey]uint64
 }
 
 // NewEthTxManager constructs an EthTxManager using the passed variables and
 // initializing internal variables.
 func NewEthTxManager(client eth.Client, config orm.ConfigReader, keyStore KeyStoreInterface, orm *orm.ORM) *EthTxManager {
 	return &EthTxManager{
-		Client:        client,
-		config:        config,
-		keyStore:      keyStore,
-		orm:           orm,
-		accountsMutex: &sync.Mutex{},
-		connected:     abool.New(),
+		Client:            client,
+		config:            config,
+		keyStore:          keyStore,
+		orm:               orm,
+		retiringAccounts:  map[common.Address]bool{},
+		accountsMutex:     &sync.Mutex{},
+		connected:         abool.New(),
+		batchesMutex:      &sync.Mutex{},
+		pendingBatches:    map[common.Address]*txBatch{},
+		gasEstimatesMutex: &sync.Mutex{},
+		gasEstimates:      map[gasEstimateKey]uint64{},
 	}
 }
 
 // Register activates accounts for outgoing transactions and client side
 // nonce management.
 func (txm *EthTxManager) Register(accts []accounts.Account) {
-	txm.accountsMutex.Lock()
-	defer txm.accountsMutex.Unlock()
-
 	cp := make([]accounts.Account, len(accts))
 	copy(cp, accts)
+
+	txm.accountsMutex.Lock()
 	txm.registeredAccounts = cp
+	txm.registrations++
+	registration := txm.registrations
+	var inactive []accounts.Account
+	for _, a := range cp {
+		if txm.findAvailableAccount(a.Address) == nil {
+			inactive = append(inactive, a)
+		}
+	}
+	txm.accountsMutex.Unlock()
+
+	if !txm.Connected() {
+		return
+	}
+
+	// Fetch the nonces of the new accounts without holding the lock, so that
+	// transactions keep being sent while the node waits on the RPC calls
+	activated := make(map[common.Address]*ManagedAccount, len(inactive))
+	for _, a := range inactive {
+		ma, err := txm.activateAccount(a)
+		if err != nil {
+			logger.Errorw("Unable to activate account", "address", a.Address.Hex(), "error", err)
+			continue
+		}
+		activated[a.Address] = ma
+	}
+
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+	if registration != txm.registrations {
+		// A later registration replaced these accounts
+		return
+	}
+	available := []*ManagedAccount{}
+	for _, a := range cp {
+		ma := txm.findAvailableAccount(a.Address)
+		if ma == nil {
+			ma = activated[a.Address]
+		}
+		if ma != nil {
+			available = append(available, ma)
+		}
+	}
+	txm.availableAccounts = available
+	txm.availableAccountIdx = 0
+}
+
+// Retire stops choosing the given registered accounts for new transactions.
+// The transactions they already sent are still bumped until confirmed.
+func (txm *EthTxManager) Retire(accts []accounts.Account) {
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	txm.retiringAccounts = make(map[common.Address]bool, len(accts))
+	for _, a := range accts {
+		txm.retiringAccounts[a.Address] = true
+	}
 }
 
 // Connected returns a bool indicating whether or not it is connected.
@@ -153,7 +230,7 @@ func (txm *EthTxManager) Connect(bn *models.Head) error {
 	}()
 
 	// Upon connecting/reconnecting, rebroadcast any transactions that are still unconfirmed
-	attempts, err := txm.orm.UnconfirmedTxAttempts()
+	attempts, err := txm.orm.UnconfirmedTxAttempts(txm.config.ChainID())
 	if err != nil {
 		merr = multierr.Append(merr, err)
 		return merr
@@ -203,8 +280,33 @@ func (txm *EthTxManager) CreateTxWithGas(surrogateID null.String, to common.Addr
 	if err != nil {
 		return nil, err
 	}
+	return txm.createTxWithGas(surrogateID, ma, to, data, gasPriceWei, gasLimit)
+}
 
+// CreateTxWithGasFrom signs and sends a transaction from the given account
+// instead of the next one in round robin. Each account keeps its own nonce,
+// so transactions of other accounts are never held up by this one.
+func (txm *EthTxManager) CreateTxWithGasFrom(surrogateID null.String, from, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+	if !txm.Connected() {
+		return nil, errors.Wrap(ErrPendingConnection, "EthTxManager#CreateTxWithGasFrom")
+	}
+	ma, err := txm.sendingAccount(from)
+	if err != nil {
+		return nil, err
+	}
+	return txm.createTxWithGas(surrogateID, ma, to, data, gasPriceWei, gasLimit)
+}
+
+func (txm *EthTxManager) createTxWithGas(surrogateID null.String, ma *ManagedAccount, to common.Address, data []byte, gasPriceWei *big.Int, gasLimit uint64) (*models.Tx, error) {
+	hardcodedGasLimit := txm.config.Dev() && gasLimit != 0
 	gasPriceWei, gasLimit = normalizeGasParams(gasPriceWei, gasLimit, txm.config)
+	if !hardcodedGasLimit {
+		var err error
+		gasLimit, err = txm.estimateGasLimit(ma.Address, to, data, nil, gasLimit)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return txm.createTx(surrogateID, ma, to, data, gasPriceWei, gasLimit, nil)
 }
 
@@ -215,7 +317,11 @@ func (txm *EthTxManager) CreateTxWithEth(from, to common.Address, value *assets.
 		return nil, errors.New("account does not exist")
 	}
 
-	return txm.createTx(null.String{}, ma, to, []byte{}, txm.config.EthGasPriceDefault(), txm.config.EthGasLimitDefault(), value)
+	gasLimit, err := txm.estimateGasLimit(ma.Address, to, []byte{}, value.ToInt(), txm.config.EthGasLimitDefault())
+	if err != nil {
+		return nil, err
+	}
+	return txm.createTx(null.String{}, ma, to, []byte{}, txm.config.EthGasPriceDefault(), gasLimit, value)
 }
 
 func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
@@ -231,22 +337,86 @@ func (txm *EthTxManager) nextAccount() (*ManagedAccount, error) {
 	return ma, nil
 }
 
+// normalizeGasParams fills in the defaults of the gas parameters that are not
+// set. A gas price is kept up to ETH_MAX_GAS_PRICE_WEI, but a gas limit is only
+// honoured in dev mode.
 func normalizeGasParams(gasPriceWei *big.Int, gasLimit uint64, config orm.ConfigReader) (*big.Int, uint64) {
-	if !config.Dev() {
-		return config.EthGasPriceDefault(), config.EthGasLimitDefault()
-	}
-
 	if gasPriceWei == nil {
 		gasPriceWei = config.EthGasPriceDefault()
 	}
+	if max := config.EthMaxGasPriceWei(); gasPriceWei.Cmp(max) > 0 {
+		logger.Warnw("Gas price exceeds EthMaxGasPriceWei, using the maximum instead", "gasPrice", gasPriceWei, "max", max)
+		gasPriceWei = max
+	}
 
-	if gasLimit == 0 {
+	if gasLimit == 0 || !config.Dev() {
 		gasLimit = config.EthGasLimitDefault()
 	}
 
 	return gasPriceWei, gasLimit
 }
 
+// gasEstimateKey identifies the calls whose gas estimates are cached
+// together: those to the same contract function.
+type gasEstimateKey struct {
+	to       common.Address
+	selector eth.FunctionSelector
+}
+
+func newGasEstimateKey(to common.Address, data []byte) gasEstimateKey {
+	key := gasEstimateKey{to: to}
+	copy(key.selector[:], data)
+	return key
+}
+
+// estimateGasLimit asks the ethereum node for the gas the transaction will
+// use, and adds the ETH_GAS_LIMIT_MULTIPLIER safety margin, capped at
+// ETH_GAS_LIMIT_MAX. If the node expects the transaction to revert,
+// ErrTxWouldRevert is returned so that no gas is burnt sending it. If the node
+// cannot be asked, the last estimate for a call to the same contract function
+// is used, or else the given fallback.
+func (txm *EthTxManager) estimateGasLimit(from, to common.Address, data []byte, value *big.Int, fallback uint64) (uint64, error) {
+	if !txm.config.EthGasEstimationEnabled() {
+		return fallback, nil
+	}
+
+	key := newGasEstimateKey(to, data)
+	estimate, err := txm.EstimateGas(from, to, data, value)
+	if isRevertError(err) {
+		return 0, errors.Wrapf(ErrTxWouldRevert, "eth_estimateGas to %s: %v", to.Hex(), err)
+	}
+
+	txm.gasEstimatesMutex.Lock()
+	if err == nil {
+		txm.gasEstimates[key] = estimate
+	} else {
+		cached, ok := txm.gasEstimates[key]
+		if !ok {
+			txm.gasEstimatesMutex.Unlock()
+			logger.Warnw("Unable to estimate gas, using the default gas limit", "to", to.Hex(), "gasLimit", fallback, "error", err)
+			return fallback, nil
+		}
+		logger.Warnw("Unable to estimate gas, using the last estimate for the same call", "to", to.Hex(), "estimate", cached, "error", err)
+		estimate = cached
+	}
+	txm.gasEstimatesMutex.Unlock()
+
+	gasLimit := uint64(float64(estimate) * txm.config.EthGasLimitMultiplier())
+	if max := txm.config.EthGasLimitMax(); gasLimit > max {
+		gasLimit = max
+	}
+	return gasLimit, nil
+}
+
+var revertErrorRegex = regexp.MustCompile(`(?i)revert|always failing|execution error`)
+
+// isRevertError returns true if the ethereum node answered a call with an
+// error saying that it reverts, as opposed to failing to answer at all.
+func isRevertError(err error) bool {
+	rpcErr, ok := errors.Cause(err).(rpc.Error)
+	return ok && revertErrorRegex.MatchString(rpcErr.Error())
+}
+
 // createTx creates an ethereum transaction, and retries to submit the
 // transaction if a nonce too low error is returned
 func (txm *EthTxManager) createTx(
@@ -408,6 +578,7 @@ func (txm *EthTxManager) newTx(
 	}
 
 	return &models.Tx{
+		ChainID:     utils.NewBig(txm.config.ChainID()),
 		From:        *from,
 		SentAt:      sentAt,
 		To:          *transaction.To(),
@@ -447,6 +618,30 @@ func (txm *EthTxManager) BumpGasUntilSafe(hash common.Hash) (*eth.TxReceipt, Att
 	return txm.checkAccountForConfirmation(tx)
 }
 
+// CancelTx replaces an unconfirmed transaction by a transfer of no ETH from
+// its sender to itself at the same nonce, priced above its latest attempt so
+// that it is mined instead. The payload of the Tx is left unchanged, the
+// no-op is only sent as its later attempts, which BumpGasUntilSafe keeps
+// bumping and records on the Tx whether the cancellation succeeded once one
+// is safe.
+func (txm *EthTxManager) CancelTx(tx *models.Tx) error {
+	if !txm.Connected() {
+		return errors.Wrap(ErrPendingConnection, "EthTxManager#CancelTx")
+	} else if tx.Confirmed {
+		return errors.New("cannot cancel a confirmed transaction")
+	} else if tx.Cancellation != models.TxCancellationNone {
+		return fmt.Errorf("transaction is already cancelled: %s", tx.Cancellation)
+	} else if len(tx.Attempts) == 0 {
+		return errors.New("cannot cancel a transaction that was never sent")
+	}
+
+	tx.Cancellation = models.TxCancellationPending
+	if err := txm.orm.SaveTx(tx); err != nil {
+		return errors.Wrap(err, "EthTxManager#CancelTx SaveTx failed")
+	}
+	return txm.bumpGas(tx, len(tx.Attempts)-1, uint64(txm.currentHead.Number))
+}
+
 func (txm *EthTxManager) checkChainForConfirmation(tx *models.Tx) (*eth.TxReceipt, AttemptState, error) {
 	blockHeight := uint64(txm.currentHead.Number)
 
@@ -470,6 +665,10 @@ func (txm *EthTxManager) checkAccountForConfirmation(tx *models.Tx) (*eth.TxRece
 	if ma != nil && ma.lastSafeNonce > tx.Nonce {
 		tx.Confirmed = true
 		tx.Hash = utils.EmptyHash
+		if tx.Cancellation == models.TxCancellationPending {
+			// Whatever was mined at this nonce was not our no-op
+			tx.Cancellation = models.TxCancellationFailed
+		}
 		if err := txm.orm.SaveTx(tx); err != nil {
 			return nil, Safe, fmt.Errorf("BumpGasUntilSafe error saving Tx confirmation to the database")
 		}
@@ -634,7 +833,7 @@ func (txm *EthTxManager) processAttempt(
 		if e != nil {
 			return receipt, state, errors.Wrap(e, "confirming confirmation attempt")
 		}
-		promUpdateEthBalance(ethBalance, tx.From)
+		promUpdateEthBalance(txm.config.ChainID(), ethBalance, tx.From)
 		return receipt, state, nil
 
 	case Unconfirmed:
@@ -717,6 +916,14 @@ func (txm *EthTxManager) handleSafe(
 	attemptIndex int) error {
 	txAttempt := tx.Attempts[attemptIndex]
 
+	if tx.Cancellation == models.TxCancellationPending {
+		if txAttempt.Cancellation {
+			tx.Cancellation = models.TxCancellationSucceeded
+		} else {
+			tx.Cancellation = models.TxCancellationFailed
+		}
+		logger.Infow(fmt.Sprintf("Cancellation of tx %d %s", tx.ID, tx.Cancellation), "txID", tx.ID, "txHash", txAttempt.Hash.String())
+	}
 	if err := txm.orm.MarkTxSafe(tx, txAttempt); err != nil {
 		return errors.Wrap(err, "handleSafe MarkTxSafe failed")
 	}
@@ -779,6 +986,10 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 			// until CHAINLINK_TX_ATTEMPT_LIMIT is reached
 			promGasBumpExceedsLimit.Inc()
 			err := fmt.Errorf("bumped gas price of %v would exceed maximum configured limit of %v, set by ETH_MAX_GAS_PRICE_WEI", bumpedGasPrice, txm.config.EthMaxGasPriceWei())
+			if tx.Cancellation == models.TxCancellationPending {
+				// The no-op can never outbid the transaction, so stop trying
+				return txm.failCancellation(tx, err)
+			}
 			logger.Error(err)
 			return err
 		}
@@ -809,6 +1020,15 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 	}
 }
 
+// failCancellation gives up on replacing the transaction by a no-op, leaving
+// the transaction to be confirmed as it was sent.
+func (txm *EthTxManager) failCancellation(tx *models.Tx, reason error) error {
+	tx.Cancellation = models.TxCancellationFailed
+	tx.CancellationError = null.StringFrom(reason.Error())
+	logger.Warnw(fmt.Sprintf("Cancellation of tx %d failed", tx.ID), "txID", tx.ID, "txHash", tx.Hash.String(), "error", reason)
+	return errors.Wrap(txm.orm.SaveTx(tx), "failCancellation SaveTx failed")
+}
+
 // createAttempt adds a new transaction attempt to a transaction record
 func (txm *EthTxManager) createAttempt(
 	tx *models.Tx,
@@ -820,14 +1040,20 @@ func (txm *EthTxManager) createAttempt(
 		return nil, fmt.Errorf("unable to locate %v as an available account in EthTxManager. Has TxManager been started or has the address been removed?", tx.From.Hex())
 	}
 
+	to, value, gasLimit, data := tx.To, tx.Value.ToInt(), tx.GasLimit, tx.Data
+	if tx.Cancellation == models.TxCancellationPending {
+		// The attempts of a cancelled transaction send the no-op replacing it
+		to, value, gasLimit, data = tx.From, big.NewInt(0), params.TxGas, []byte{}
+	}
+
 	newTxAttempt, err := txm.newTx(
 		ma.Account,
 		tx.Nonce,
-		tx.To,
-		tx.Value.ToInt(),
-		tx.GasLimit,
+		to,
+		value,
+		gasLimit,
 		gasPriceWei,
-		tx.Data,
+		data,
 		&ma.Address,
 		blockHeight,
 	)
@@ -850,24 +1076,45 @@ func (txm *EthTxManager) createAttempt(
 }
 
 // NextActiveAccount uses round robin to select a managed account
-// from the list of available accounts as defined in Register(...)
+// from the list of available accounts as defined in Register(...),
+// skipping the accounts being retired.
 func (txm *EthTxManager) NextActiveAccount() *ManagedAccount {
 	txm.accountsMutex.Lock()
 	defer txm.accountsMutex.Unlock()
 
-	if len(txm.availableAccounts) == 0 {
-		return nil
+	for range txm.availableAccounts {
+		account := txm.availableAccounts[txm.availableAccountIdx]
+		txm.availableAccountIdx = (txm.availableAccountIdx + 1) % len(txm.availableAccounts)
+		if !txm.retiringAccounts[account.Address] {
+			return account
+		}
 	}
-
-	account := txm.availableAccounts[txm.availableAccountIdx]
-	txm.availableAccountIdx = (txm.availableAccountIdx + 1) % len(txm.availableAccounts)
-	return account
+	return nil
 }
 
 func (txm *EthTxManager) getAccount(from common.Address) *ManagedAccount {
 	txm.accountsMutex.Lock()
 	defer txm.accountsMutex.Unlock()
 
+	return txm.findAvailableAccount(from)
+}
+
+// sendingAccount returns the available account for from, unless it is being
+// retired.
+func (txm *EthTxManager) sendingAccount(from common.Address) (*ManagedAccount, error) {
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	ma := txm.findAvailableAccount(from)
+	if ma == nil {
+		return nil, fmt.Errorf("account %s is not available for sending", from.Hex())
+	} else if txm.retiringAccounts[from] {
+		return nil, fmt.Errorf("account %s is being retired", from.Hex())
+	}
+	return ma, nil
+}
+
+func (txm *EthTxManager) findAvailableAccount(from common.Address) *ManagedAccount {
 	for _, a := range txm.availableAccounts {
 		if a.Address == from {
 			return a
@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -68,6 +69,125 @@ func TestTxManager_CreateTx_Success(t *testing.T) {
 	ethClient.AssertExpectations(t)
 }
 
+func TestTxManager_CreateTxWithGas_EstimatesGasLimit(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_GAS_ESTIMATION_ENABLED", true)
+	config.Set("ETH_GAS_LIMIT_MULTIPLIER", 1.5)
+	config.Set("ETH_GAS_LIMIT_MAX", 600000)
+	keyStore := strpkg.NewKeyStore(config.KeysDir())
+	account, err := keyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	manager := strpkg.NewEthTxManager(ethClient, config, keyStore, store.ORM)
+	manager.Register(keyStore.Accounts())
+
+	ethClient.On("GetNonce", account.Address).Return(uint64(0), nil)
+	require.NoError(t, manager.Connect(cltest.Head(1)))
+	ethClient.On("SendRawTx", mock.Anything).Return(cltest.NewHash(), nil)
+
+	to := cltest.NewAddress()
+	data := hexutil.MustDecode("0x4ab0d1900000")
+	otherData := hexutil.MustDecode("0x12345678")
+
+	tests := []struct {
+		name         string
+		data         []byte
+		estimate     uint64
+		estimateErr  error
+		wantGasLimit uint64
+	}{
+		{"no estimate yet", data, 0, errors.New("connection refused"), config.EthGasLimitDefault()},
+		{"estimate with margin", data, 100000, nil, 150000},
+		{"last estimate of the same call", data, 0, errors.New("connection refused"), 150000},
+		{"other function", otherData, 0, errors.New("connection refused"), config.EthGasLimitDefault()},
+		{"capped", data, 500000, nil, 600000},
+	}
+
+	for _, test := range tests {
+		ethClient.On("EstimateGas", account.Address, to, test.data, (*big.Int)(nil)).Return(test.estimate, test.estimateErr).Once()
+
+		tx, err := manager.CreateTxWithGas(null.String{}, to, test.data, nil, 0)
+		require.NoError(t, err, test.name)
+		assert.Equal(t, test.wantGasLimit, tx.GasLimit, test.name)
+	}
+
+	ethClient.On("EstimateGas", account.Address, to, data, (*big.Int)(nil)).Return(uint64(0), revertError{"execution reverted"}).Once()
+	_, err = manager.CreateTxWithGas(null.String{}, to, data, nil, 0)
+	assert.True(t, errors.Is(err, strpkg.ErrTxWouldRevert))
+
+	ethClient.AssertExpectations(t)
+}
+
+// revertError is the error returned by the ethereum node for a call that
+// reverts.
+type revertError struct{ message string }
+
+func (e revertError) Error() string  { return e.message }
+func (e revertError) ErrorCode() int { return -32000 }
+
+func TestTxManager_CreateBatchedTx(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+
+	forwarder := cltest.NewAddress()
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_TX_BATCH_WINDOW", "100ms")
+	config.Set("MULTICALL_FORWARDER_ADDRESS", forwarder.Hex())
+	keyStore := strpkg.NewKeyStore(config.KeysDir())
+	_, err := keyStore.NewAccount(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	manager := strpkg.NewEthTxManager(ethClient, config, keyStore, store.ORM)
+	manager.Register(keyStore.Accounts())
+
+	ethClient.On("GetNonce", mock.Anything).Return(uint64(0), nil)
+	require.NoError(t, manager.Connect(cltest.Head(1)))
+	ethClient.On("SendRawTx", mock.Anything).Return(cltest.NewHash(), nil).Once()
+
+	oracle := cltest.NewAddress()
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	var runs []models.JobRun
+	for i, data := range [][]byte{hexutil.MustDecode("0x01"), hexutil.MustDecode("0x02")} {
+		run := cltest.CreateJobRunWithStatus(t, store, job, models.RunStatusInProgress)
+		index, err := manager.CreateBatchedTx(run.ID, oracle, data, 0)
+		require.NoError(t, err, "returns without waiting for the batch to be sent")
+		assert.Equal(t, i, index)
+		runs = append(runs, run)
+	}
+
+	sentCall := func(run models.JobRun) *models.TxBatchCall {
+		var call *models.TxBatchCall
+		gomega.NewGomegaWithT(t).Eventually(func() bool {
+			var err error
+			call, err = store.FindTxBatchCall(run.ID)
+			require.NoError(t, err)
+			return call.Sent()
+		}).Should(gomega.BeTrue())
+		return call
+	}
+	first, second := sentCall(runs[0]), sentCall(runs[1])
+	assert.Equal(t, *first.TxID, *second.TxID, "calls within the window share a transaction")
+
+	tx, err := store.FindTx(*first.TxID)
+	require.NoError(t, err)
+	assert.Equal(t, forwarder, tx.To)
+	assert.Equal(t, 2*config.EthGasLimitDefault(), tx.GasLimit)
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestTxManager_CreateTx_RoundRobinSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -227,7 +347,7 @@ func TestTxManager_CreateTx_AttemptErrorDoesNotIncrementNonce(t *testing.T) {
 		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(nonce))
 		ethMock.Register("eth_chainId", store.Config.ChainID())
 	})
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(sentAt)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, sentAt)))
 	assert.NoError(t, app.StartAndConnect())
 
 	ethMock.Context("manager.CreateTx#1", func(ethMock *cltest.EthMock) {
@@ -377,7 +497,7 @@ func TestTxManager_BumpGasUntilSafe_lessThanGasBumpThreshold(t *testing.T) {
 	ethMock := app.EthMock
 	ethMock.Register("eth_getTransactionCount", "0x0")
 	ethMock.Register("eth_chainId", config.ChainID())
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold-1)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, gasThreshold-1)))
 	require.NoError(t, app.StartAndConnect())
 
 	tx := cltest.CreateTx(t, store, from, sentAt)
@@ -413,7 +533,7 @@ func TestTxManager_BumpGasUntilSafe_atGasBumpThreshold(t *testing.T) {
 	ethMock := app.EthMock
 	ethMock.Register("eth_getTransactionCount", "0x0")
 	ethMock.Register("eth_chainId", config.ChainID())
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, gasThreshold)))
 	require.NoError(t, app.StartAndConnect())
 
 	tx := cltest.CreateTx(t, store, from, sentAt)
@@ -450,7 +570,7 @@ func TestTxManager_BumpGasUntilSafe_atGasBumpThreshold_bumpsGasMoreInCaseOfUnder
 	ethMock := app.EthMock
 	ethMock.Register("eth_getTransactionCount", "0x0")
 	ethMock.Register("eth_chainId", config.ChainID())
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, gasThreshold)))
 	require.NoError(t, app.StartAndConnect())
 
 	tx := cltest.CreateTxWithNonceAndGasPrice(t, store, from, sentAt, 0, 48000000000)
@@ -502,7 +622,7 @@ func TestTxManager_BumpGasUntilSafe_atGasBumpThreshold_returnsErrorIfMaxGasPrice
 	ethMock := app.EthMock
 	ethMock.Register("eth_getTransactionCount", "0x0")
 	ethMock.Register("eth_chainId", config.ChainID())
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, gasThreshold)))
 	require.NoError(t, app.StartAndConnect())
 
 	tx := cltest.CreateTxWithNonceAndGasPrice(t, store, from, sentAt, 0, 499000000000)
@@ -544,7 +664,7 @@ func TestTxManager_BumpGasUntilSafe_exceedsGasBumpThreshold(t *testing.T) {
 	ethMock := app.EthMock
 	ethMock.Register("eth_getTransactionCount", "0x0")
 	ethMock.Register("eth_chainId", config.ChainID())
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold+1)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, gasThreshold+1)))
 	require.NoError(t, app.StartAndConnect())
 
 	tx := cltest.CreateTx(t, store, from, sentAt)
@@ -581,7 +701,7 @@ func TestTxManager_BumpGasUntilSafe_confirmed(t *testing.T) {
 	nonce := uint64(234)
 	gasThreshold := sentAt + config.EthGasBumpThreshold()
 	minConfs := config.MinOutgoingConfirmations() - 1
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(gasThreshold+minConfs-1)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, gasThreshold+minConfs-1)))
 	require.NoError(t, app.StartAndConnect())
 
 	txm := store.TxManager
@@ -636,7 +756,7 @@ func TestTxManager_BumpGasUntilSafe_safe(t *testing.T) {
 
 			gasThreshold := sentAt + config.EthGasBumpThreshold()
 			minConfs := config.MinOutgoingConfirmations() - 1
-			head := cltest.Head(gasThreshold + minConfs + test.confsDiff)
+			head := cltest.DefaultChainHead(store, gasThreshold+minConfs+test.confsDiff)
 			require.NoError(t, app.Store.ORM.CreateHead(head))
 			require.NoError(t, app.StartAndConnect())
 
@@ -668,6 +788,92 @@ func TestTxManager_BumpGasUntilSafe_safe(t *testing.T) {
 	}
 }
 
+func TestTxManager_CancelTx(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	defer cleanup()
+	app.EthMock.Context("app.Start()", func(meth *cltest.EthMock) {
+		meth.Register("eth_getTransactionCount", "0x1")
+		meth.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+	store := app.Store
+	config := store.Config
+
+	sentAt := uint64(23456)
+	head := cltest.DefaultChainHead(store, sentAt+config.MinOutgoingConfirmations())
+	require.NoError(t, store.ORM.CreateHead(head))
+	require.NoError(t, app.StartAndConnect())
+
+	txm := store.TxManager
+	from := cltest.GetAccountAddress(t, store)
+	tx := cltest.CreateTxWithNonceAndGasPrice(t, store, from, sentAt, 234, 1)
+
+	app.EthMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	require.NoError(t, txm.CancelTx(tx))
+	assert.Error(t, txm.CancelTx(tx))
+
+	tx, err := store.FindTx(tx.ID)
+	require.NoError(t, err)
+	require.Len(t, tx.Attempts, 2)
+	assert.Equal(t, models.TxCancellationPending, tx.Cancellation)
+	assert.Equal(t, common.Address{}, tx.To)
+	assert.NotEmpty(t, tx.Data)
+	assert.Equal(t, uint64(250000), tx.GasLimit)
+	assert.False(t, tx.Attempts[0].Cancellation)
+	assert.True(t, tx.Attempts[1].Cancellation)
+	assert.True(t, tx.Attempts[1].GasPrice.ToInt().Cmp(tx.Attempts[0].GasPrice.ToInt()) > 0)
+
+	noop, err := utils.DecodeEthereumTx(hexutil.Encode(tx.Attempts[1].SignedRawTx))
+	require.NoError(t, err)
+	assert.Equal(t, from, *noop.To())
+	assert.Empty(t, noop.Data())
+	assert.Equal(t, uint64(21000), noop.Gas())
+	assert.Equal(t, tx.Nonce, noop.Nonce())
+
+	app.EthMock.Register("eth_getTransactionReceipt", eth.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.Int(sentAt)})
+	app.EthMock.Register("eth_getBalance", "0x100")
+	app.EthMock.Register("eth_call", "0x100")
+	_, state, err := txm.BumpGasUntilSafe(tx.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, strpkg.Safe, state)
+
+	tx, err = store.FindTx(tx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TxCancellationSucceeded, tx.Cancellation)
+}
+
+func TestTxManager_CancelTx_AtMaxGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config, configCleanup := cltest.NewConfig(t)
+	defer configCleanup()
+	config.Set("ETH_MAX_GAS_PRICE_WEI", 1000)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	app.EthMock.Context("app.Start()", func(meth *cltest.EthMock) {
+		meth.Register("eth_getTransactionCount", "0x1")
+		meth.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+	store := app.Store
+
+	sentAt := uint64(23456)
+	require.NoError(t, store.ORM.CreateHead(cltest.DefaultChainHead(store, sentAt+config.MinOutgoingConfirmations())))
+	require.NoError(t, app.StartAndConnect())
+
+	txm := store.TxManager
+	from := cltest.GetAccountAddress(t, store)
+	tx := cltest.CreateTxWithNonceAndGasPrice(t, store, from, sentAt, 234, 1000)
+
+	require.NoError(t, txm.CancelTx(tx))
+
+	tx, err := store.FindTx(tx.ID)
+	require.NoError(t, err)
+	require.Len(t, tx.Attempts, 1)
+	assert.Equal(t, models.TxCancellationFailed, tx.Cancellation)
+	assert.Contains(t, tx.CancellationError.String, "ETH_MAX_GAS_PRICE_WEI")
+}
+
 func TestTxManager_BumpGasUntilSafe_laterConfirmedTx(t *testing.T) {
 	t.Parallel()
 
@@ -781,7 +987,7 @@ func TestTxManager_BumpGasUntilSafe_erroring(t *testing.T) {
 
 			ethMock := app.EthMock
 			ethMock.ShouldCall(test.mockSetup).During(func() {
-				require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(test.blockHeight)))
+				require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, test.blockHeight)))
 				ethMock.Register("eth_chainId", store.Config.ChainID())
 				ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
 
@@ -906,6 +1112,44 @@ func TestTxManager_Register(t *testing.T) {
 	assert.Equal(t, uint64(0x2d0), aa.Nonce())
 }
 
+func TestTxManager_Register_WhileConnected(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethMock := &cltest.EthMock{}
+	txm := strpkg.NewEthTxManager(
+		&eth.CallerSubscriberClient{CallerSubscriber: ethMock},
+		orm.NewConfig(),
+		nil,
+		store.ORM,
+	)
+
+	accounts := []accounts.Account{
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca001")},
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca002")},
+	}
+
+	ethMock.Register("eth_getTransactionCount", `0x1D0`)
+	txm.Register(accounts[:1])
+	require.NoError(t, txm.Connect(cltest.Head(1)))
+	ethMock.EventuallyAllCalled(t)
+	require.NoError(t, txm.GetAvailableAccount(accounts[0].Address).GetAndIncrementNonce(func(uint64) error { return nil }))
+
+	// Only the new account is activated, the known one keeps its local nonce
+	ethMock.Register("eth_getTransactionCount", `0x2D0`)
+	txm.Register(accounts)
+	ethMock.EventuallyAllCalled(t)
+
+	assert.Equal(t, uint64(0x1d1), txm.GetAvailableAccount(accounts[0].Address).Nonce())
+	assert.Equal(t, uint64(0x2d0), txm.GetAvailableAccount(accounts[1].Address).Nonce())
+
+	txm.Register(accounts[1:])
+	assert.Nil(t, txm.GetAvailableAccount(accounts[0].Address))
+	assert.NotNil(t, txm.GetAvailableAccount(accounts[1].Address))
+}
+
 func TestTxManager_NextActiveAccount_RoundRobin(t *testing.T) {
 	t.Parallel()
 
@@ -944,6 +1188,41 @@ func TestTxManager_NextActiveAccount_RoundRobin(t *testing.T) {
 	assert.Equal(t, a0, a2)
 }
 
+func TestTxManager_NextActiveAccount_SkipsRetiring(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	ethMock := &cltest.EthMock{}
+	txm := strpkg.NewEthTxManager(
+		&eth.CallerSubscriberClient{CallerSubscriber: ethMock},
+		orm.NewConfig(),
+		nil,
+		store.ORM,
+	)
+
+	accounts := []accounts.Account{
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca001")},
+		accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca002")},
+	}
+
+	ethMock.Register("eth_getTransactionCount", `0x1D0`)
+	ethMock.Register("eth_getTransactionCount", `0x2D0`)
+
+	txm.Register(accounts)
+	txm.Retire(accounts[:1])
+	txm.Connect(cltest.Head(1))
+	ethMock.EventuallyAllCalled(t)
+
+	assert.Equal(t, accounts[1].Address, txm.NextActiveAccount().Address)
+	assert.Equal(t, accounts[1].Address, txm.NextActiveAccount().Address)
+	assert.NotNil(t, txm.GetAvailableAccount(accounts[0].Address))
+
+	txm.Retire(accounts)
+	assert.Nil(t, txm.NextActiveAccount())
+}
+
 func TestTxManager_ReloadNonce(t *testing.T) {
 	t.Parallel()
 
@@ -992,7 +1271,7 @@ func TestTxManager_WithdrawLink_HappyPath(t *testing.T) {
 		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(nonce))
 		ethMock.Register("eth_chainId", config.ChainID())
 	})
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(sentAt)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, sentAt)))
 	assert.NoError(t, app.StartAndConnect())
 
 	ethMock.Context("txm.CreateTx#1", func(ethMock *cltest.EthMock) {
@@ -1151,13 +1430,15 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 		ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(nonce))
 		ethMock.Register("eth_chainId", config.ChainID())
 	})
-	require.NoError(t, app.Store.ORM.CreateHead(cltest.Head(1)))
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, 1)))
 	assert.NoError(t, app.StartAndConnect())
 
 	customGasPrice := utils.NewBig(big.NewInt(1337))
 	customGasLimit := uint64(10009)
 
 	defaultGasPrice := utils.NewBig(config.EthGasPriceDefault())
+	maxGasPrice := utils.NewBig(config.EthMaxGasPriceWei())
+	aboveMaxGasPrice := utils.NewBig(new(big.Int).Add(config.EthMaxGasPriceWei(), big.NewInt(1)))
 
 	tests := []struct {
 		name             string
@@ -1169,8 +1450,10 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 	}{
 		{"dev", true, customGasPrice, customGasLimit, customGasPrice, customGasLimit},
 		{"dev but not set", true, nil, 0, defaultGasPrice, config.EthGasLimitDefault()},
-		{"not dev", false, customGasPrice, customGasLimit, defaultGasPrice, config.EthGasLimitDefault()},
+		{"not dev", false, customGasPrice, customGasLimit, customGasPrice, config.EthGasLimitDefault()},
 		{"not dev not set", false, nil, 0, defaultGasPrice, config.EthGasLimitDefault()},
+		{"dev above max", true, aboveMaxGasPrice, 0, maxGasPrice, config.EthGasLimitDefault()},
+		{"not dev above max", false, aboveMaxGasPrice, 0, maxGasPrice, config.EthGasLimitDefault()},
 	}
 
 	for _, test := range tests {
@@ -1193,6 +1476,38 @@ func TestTxManager_CreateTxWithGas(t *testing.T) {
 	}
 }
 
+func TestTxManager_CreateTxWithGas_GasEstimationEnabled(t *testing.T) {
+	t.Parallel()
+
+	config, configCleanup := cltest.NewConfig(t)
+	defer configCleanup()
+	config.Set("ETH_GAS_ESTIMATION_ENABLED", true)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", "0x100")
+		ethMock.Register("eth_chainId", store.Config.ChainID())
+	})
+	require.NoError(t, app.Store.ORM.CreateHead(cltest.DefaultChainHead(app.Store, 1)))
+	require.NoError(t, app.StartAndConnect())
+
+	ethMock.Context("manager.CreateTx", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_estimateGas", "0x186a0")
+		ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	})
+
+	tx, err := manager.CreateTxWithGas(null.String{}, cltest.NewAddress(), hexutil.MustDecode("0x4ab0d190"), nil, 0)
+	require.NoError(t, err)
+	wantGasLimit := uint64(float64(100000) * store.Config.EthGasLimitMultiplier())
+	assert.Equal(t, wantGasLimit, tx.GasLimit)
+
+	ethMock.EventuallyAllCalled(t)
+}
+
 func TestTxManager_RebroadcastUnconfirmedTxsOnReconnect(t *testing.T) {
 	t.Parallel()
 
@@ -11,3 +11,30 @@ type HeadTrackable interface {
 	Disconnect()
 	OnNewHead(*models.Head)
 }
+
+// ReorgTrackable is a HeadTrackable that also wishes to be told about
+// reorganisations of the chain. OnReorg is called before OnNewHead is called
+// with the head that revealed the reorganisation.
+type ReorgTrackable interface {
+	HeadTrackable
+	OnReorg(Reorg)
+}
+
+// Reorg describes a reorganisation of the chain: the heads of the old branch
+// after CommonAncestor, up to OldHead, were replaced by a branch ending at
+// NewHead.
+type Reorg struct {
+	// CommonAncestor is the last head shared by both branches, or nil if the
+	// branches forked before the heads kept in history.
+	CommonAncestor *models.Head
+	OldHead        *models.Head
+	NewHead        *models.Head
+	// Depth is the number of heads of the old branch that were replaced. It
+	// is a lower bound when CommonAncestor is nil.
+	Depth int64
+}
+
+// ForkNumber returns the number of the last head shared by both branches.
+func (r Reorg) ForkNumber() int64 {
+	return r.OldHead.Number - r.Depth
+}
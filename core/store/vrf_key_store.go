@@ -26,6 +26,10 @@ type VRFKeyStore struct {
 
 type InMemoryKeyStore = map[vrfkey.PublicKey]vrfkey.PrivateKey
 
+// ErrVRFKeyNotUnlocked is returned when generating a proof with a key whose
+// secret is not held in memory.
+var ErrVRFKeyNotUnlocked = errors.New("VRF key has not been unlocked")
+
 // NewVRFKeyStore returns an empty VRFKeyStore
 func NewVRFKeyStore(store *Store) *VRFKeyStore {
 	return &VRFKeyStore{
@@ -46,7 +50,7 @@ func (ks *VRFKeyStore) GenerateProof(k *vrfkey.PublicKey, seed *big.Int) (
 	defer ks.lock.RUnlock()
 	privateKey, found := ks.keys[*k]
 	if !found {
-		return vrf.MarshaledProof{}, fmt.Errorf("key %s has not been unlocked", k)
+		return vrf.MarshaledProof{}, errors.Wrap(ErrVRFKeyNotUnlocked, k.String())
 	}
 	return privateKey.MarshaledProof(seed)
 }
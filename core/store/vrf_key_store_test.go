@@ -78,6 +78,7 @@ func TestKeyStoreEndToEnd(t *testing.T) {
 		"should not be able to generate VRF proofs unless key has been unlocked")
 	require.Contains(t, err.Error(), "has not been unlocked",
 		"complaint when attempting to generate VRF proof with unclocked key should be that it's locked")
+	assert.Equal(t, strpkg.ErrVRFKeyNotUnlocked, errors.Cause(err))
 	encryptedKey, err := ks.GetSpecificKey(key) // Can export a key to bytes
 	require.NoError(t, err, "should be able to get a specific key")
 	assert.True(t, bytes.Equal(encryptedKey.PublicKey[:], key[:]),
@@ -0,0 +1,113 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// ChainsController manages the chains served by the node. Chains created or
+// deleted take effect when the node restarts.
+type ChainsController struct {
+	App chainlink.Application
+}
+
+// Index lists the default chain and every additional chain.
+// Example:
+//  "<application>/chains"
+func (cc *ChainsController) Index(c *gin.Context) {
+	chains, err := presenters.NewChains(cc.App.GetStore())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, chains, "chains")
+}
+
+// Create saves an additional chain.
+// Example:
+//  "<application>/chains"
+func (cc *ChainsController) Create(c *gin.Context) {
+	chain := models.Chain{}
+	if err := c.ShouldBindJSON(&chain); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	chain.Enabled = true
+
+	store := cc.App.GetStore()
+	if err := services.ValidateChain(chain, store); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := store.CreateChain(&chain); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	presenter, err := presenters.NewChain(store, chain)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenter, "chain", http.StatusCreated)
+}
+
+// Destroy deletes an additional chain.
+// Example:
+//  "<application>/chains/:ChainID"
+func (cc *ChainsController) Destroy(c *gin.Context) {
+	id, ok := parseChainID(c)
+	if !ok {
+		return
+	}
+
+	store := cc.App.GetStore()
+	if _, err := store.FindChain(id); errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("chain not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err := store.DeleteChain(id); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "chain", http.StatusNoContent)
+}
+
+// Transactions returns the paginated transactions sent on a chain.
+// Example:
+//  "<application>/chains/:ChainID/transactions"
+func (cc *ChainsController) Transactions(c *gin.Context, size, page, offset int) {
+	id, ok := parseChainID(c)
+	if !ok {
+		return
+	}
+
+	txs, count, err := cc.App.GetStore().ChainTransactions(id, offset, size)
+	ptxs := make([]presenters.Tx, len(txs))
+	for i, tx := range txs {
+		ptxs[i] = presenters.NewTx(&tx)
+	}
+	paginatedResponse(c, "Transactions", size, page, ptxs, count, err)
+}
+
+func parseChainID(c *gin.Context) (*big.Int, bool) {
+	id, ok := new(big.Int).SetString(c.Param("ChainID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid chain ID"))
+		return nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,66 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainsController_Create_Index_Destroy(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := []byte(`{"id":"42","ethUrl":"ws://chain42:8546","config":{"minIncomingConfirmations":12}}`)
+	resp, cleanup := client.Post("/v2/chains", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	var created presenters.Chain
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, "42", created.ID.String())
+	assert.False(t, created.Default)
+	assert.False(t, created.Serving, "chains are only dialed on boot")
+
+	resp, cleanup = client.Get("/v2/chains")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var chains []presenters.Chain
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &chains))
+	require.Len(t, chains, 2)
+	assert.True(t, chains[0].Default)
+	assert.Equal(t, app.Store.Config.ChainID().String(), chains[0].ID.String())
+	assert.Equal(t, "42", chains[1].ID.String())
+
+	resp, cleanup = client.Delete("/v2/chains/42")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/chains/42")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestChainsController_Create_RejectsDefaultChain(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := []byte(`{"id":"` + app.Store.Config.ChainID().String() + `","ethUrl":"ws://localhost:8546"}`)
+	resp, cleanup := client.Post("/v2/chains", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
@@ -0,0 +1,42 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// FluxMonitorRoundsController exposes the decisions and submissions of flux
+// monitor jobs.
+type FluxMonitorRoundsController struct {
+	App chainlink.Application
+}
+
+// Index returns the paginated decisions of the flux monitor of a job, most
+// recent first, including those of archived jobs.
+// Example:
+//  "<application>/specs/:SpecID/flux_rounds"
+func (frc *FluxMonitorRoundsController) Index(c *gin.Context, size, page, offset int) {
+	id, err := models.NewIDFromString(c.Param("SpecID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	store := frc.App.GetStore()
+	if _, err = store.Unscoped().FindJob(id); errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rounds, count, err := store.FluxMonitorRoundsFor(id, offset, size)
+	paginatedResponse(c, "FluxMonitorRounds", size, page, rounds, count, err)
+}
@@ -0,0 +1,64 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFluxMonitorRoundsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	for i := uint32(1); i <= 3; i++ {
+		round := models.NewFluxMonitorRound(job.ID, i, models.FluxMonitorTriggerPoll)
+		round.Answer = decimal.NullDecimal{Decimal: decimal.NewFromInt(int64(100 + i)), Valid: true}
+		round.SkipReason = "deviation < threshold"
+		require.NoError(t, store.CreateFluxMonitorRound(round))
+	}
+
+	resp, cleanup := client.Get("/v2/specs/" + job.ID.String() + "/flux_rounds?size=2")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var rounds []models.FluxMonitorRound
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &rounds, &links))
+	assert.NotEmpty(t, links["next"].Href)
+	assert.Empty(t, links["prev"].Href)
+
+	require.Len(t, rounds, 2)
+	assert.Equal(t, uint32(3), rounds[0].RoundID, "expected most recent rounds first")
+	assert.Equal(t, "103", rounds[0].Answer.Decimal.String())
+	assert.Equal(t, "deviation < threshold", rounds[0].SkipReason)
+	assert.Equal(t, uint32(2), rounds[1].RoundID)
+}
+
+func TestFluxMonitorRoundsController_Index_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/specs/" + models.NewID().String() + "/flux_rounds")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -143,7 +144,7 @@ func (jrc *JobRunsController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.JobRun{JobRun: jr}, "job run")
+	jsonAPIResponse(c, jrc.present(jr), "job run")
 }
 
 // Update allows external adapters to resume a JobRun, reporting the result of
@@ -228,5 +229,22 @@ func (jrc *JobRunsController) Cancel(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
+	jsonAPIResponse(c, jrc.present(*jr), "job run")
+}
+
+// present adds the state of the cancellation of the run's latest cancelled
+// transaction, if any, to the run.
+func (jrc *JobRunsController) present(jr models.JobRun) presenters.JobRun {
+	pjr := presenters.JobRun{JobRun: jr}
+	txs, err := jrc.App.GetStore().TxsBySurrogateID(jr.ID.String())
+	if err != nil {
+		logger.Errorw("Unable to find transactions of run", jr.ForLogger("error", err)...)
+	}
+	for _, tx := range txs {
+		if tx.Cancellation != models.TxCancellationNone {
+			pjr.TxCancellation = tx.Cancellation
+			pjr.TxCancellationError = tx.CancellationError.String
+		}
+	}
+	return pjr
 }
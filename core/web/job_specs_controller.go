@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
@@ -80,6 +81,10 @@ func (jsc *JobSpecsController) Create(c *gin.Context) {
 		jsonAPIError(c, httpStatus, err)
 		return
 	}
+	if err := fluxmonitor.DetectAggregatorVersions(jsc.App.GetStore(), &js); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
 	if err := NotifyExternalInitiator(js, jsc.App.GetStore()); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -138,6 +143,49 @@ func (jsc *JobSpecsController) Destroy(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Pause stops the flux monitor of a job spec from polling and submitting
+// answers, until resumed.
+// Example:
+//  "<application>/specs/:SpecID/pause"
+func (jsc *JobSpecsController) Pause(c *gin.Context) {
+	jsc.setPaused(c, jsc.App.PauseFluxMonitorJob)
+}
+
+// Resume lets the paused flux monitor of a job spec poll and submit answers
+// again.
+// Example:
+//  "<application>/specs/:SpecID/resume"
+func (jsc *JobSpecsController) Resume(c *gin.Context) {
+	jsc.setPaused(c, jsc.App.ResumeFluxMonitorJob)
+}
+
+func (jsc *JobSpecsController) setPaused(c *gin.Context, setPaused func(*models.ID) error) {
+	id, err := models.NewIDFromString(c.Param("SpecID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = setPaused(id)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	} else if errors.Cause(err) == chainlink.ErrNotFluxMonitorJob {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	j, err := jsc.App.GetStore().FindJob(id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, jobPresenter(jsc, j), "job")
+}
+
 func jobPresenter(jsc *JobSpecsController, job models.JobSpec) presenters.JobSpec {
 	store := jsc.App.GetStore()
 	jobLinkEarned, _ := store.LinkEarnedFor(&job)
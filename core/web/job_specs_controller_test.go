@@ -11,6 +11,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -326,11 +328,48 @@ func TestJobSpecsController_Create_FluxMonitor_enabled(t *testing.T) {
 
 	client := app.NewHTTPClient()
 
+	app.EthMock.Register("eth_getCode", "0x6080")
+	app.EthMock.Register("eth_call", "0x")
 	jsonStr := cltest.MustReadFile(t, "testdata/flux_monitor_job.json")
 	resp, cleanup := client.Post("/v2/specs", bytes.NewBuffer(jsonStr))
 	defer cleanup()
 
 	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var j models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &j))
+	j, err := app.Store.FindJob(j.ID)
+	require.NoError(t, err)
+	require.Len(t, j.Initiators, 1)
+	assert.Equal(t, string(contracts.AggregatorVersionFlux), j.Initiators[0].AggregatorVersion)
+}
+
+func TestJobSpecsController_Create_FluxMonitor_UndetectedAggregatorVersion(t *testing.T) {
+	config := cltest.NewTestConfig(t)
+	config.Set("CHAINLINK_DEV", "FALSE")
+	config.Set("FEATURE_FLUX_MONITOR", "TRUE")
+
+	app, cleanup := cltest.NewApplicationWithConfig(t, config, cltest.LenientEthMock)
+	defer cleanup()
+
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	app.EthMock.RegisterError("eth_getCode", "connection refused")
+	jsonStr := cltest.MustReadFile(t, "testdata/flux_monitor_job.json")
+	resp, cleanup := client.Post("/v2/specs", bytes.NewBuffer(jsonStr))
+	defer cleanup()
+
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var j models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &j))
+	j, err := app.Store.FindJob(j.ID)
+	require.NoError(t, err)
+	require.Len(t, j.Initiators, 1)
+	assert.Empty(t, j.Initiators[0].AggregatorVersion,
+		"a version which cannot be detected is left to be detected again")
 }
 
 func TestJobSpecsController_Create_FluxMonitor_Bridge(t *testing.T) {
@@ -558,6 +597,58 @@ func TestJobSpecsController_Destroy(t *testing.T) {
 	assert.Equal(t, 0, len(app.ChainlinkApplication.JobSubscriber.Jobs()))
 }
 
+func TestJobSpecsController_PauseResume(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	fluxMonitor := new(mocks.Service)
+	fluxMonitor.On("Start").Return(nil)
+	fluxMonitor.On("Stop").Maybe()
+	app.ChainlinkApplication.FluxMonitor = fluxMonitor
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	fluxMonitor.On("PauseJob", job.ID).Once()
+	resp, cleanup := client.Put("/v2/specs/"+job.ID.String()+"/pause", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var paused presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &paused))
+	assert.True(t, paused.Initiators[0].Paused)
+
+	fluxMonitor.On("ResumeJob", job.ID).Once()
+	resp, cleanup = client.Put("/v2/specs/"+job.ID.String()+"/resume", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var resumed presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resumed))
+	assert.False(t, resumed.Initiators[0].Paused)
+
+	fluxMonitor.AssertExpectations(t)
+}
+
+func TestJobSpecsController_Pause_Errors(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	resp, cleanup := client.Put("/v2/specs/"+job.ID.String()+"/pause", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Put("/v2/specs/"+models.NewID().String()+"/pause", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
 func TestJobSpecsController_Destroy_MultipleJobs(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
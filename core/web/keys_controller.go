@@ -1,13 +1,18 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 )
 
 // KeysController manages account keys
@@ -41,3 +46,184 @@ func (kc *KeysController) Create(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, presenters.NewAccount{Account: &account}, "account", http.StatusCreated)
 }
+
+// Index lists the ethereum keys of the node, only those that may be given
+// out to receive funds when receiving is true
+// Example:
+//  "<application>/keys?receiving=true"
+func (kc *KeysController) Index(c *gin.Context) {
+	keys, err := kc.App.GetStore().Keys()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	receiving := c.Query("receiving") == "true"
+	presented := []presenters.ETHKey{}
+	for _, key := range keys {
+		if receiving && !key.CanReceive() {
+			continue
+		}
+		presented = append(presented, presenters.NewETHKey(key))
+	}
+	jsonAPIResponse(c, presented, "keys")
+}
+
+// Import adds an encrypted JSON key
+// Example:
+//  "<application>/keys/import"
+func (kc *KeysController) Import(c *gin.Context) {
+	request := models.ImportKeyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	store := kc.App.GetStore()
+	if err := store.KeyStore.Unlock(request.CurrentPassword); err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	key, err := store.ImportKey(request.Key, request.KeyPassword, request.CurrentPassword)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewETHKey(key), "key", http.StatusCreated)
+}
+
+// Export returns a key as an encrypted JSON key file
+// Example:
+//  "<application>/keys/export"
+func (kc *KeysController) Export(c *gin.Context) {
+	request := models.ExportKeyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	keyJSON, err := kc.App.GetStore().ExportKey(request.Address, request.CurrentPassword, request.NewPassword)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Key not found"))
+		return
+	} else if err == strpkg.ErrRemoteSignerKeys {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", keyJSON)
+}
+
+// Update changes what a key is used for, or disables it
+// Example:
+//  "<application>/keys/:address"
+func (kc *KeysController) Update(c *gin.Context) {
+	address, ok := kc.address(c)
+	if !ok {
+		return
+	}
+	request := models.UpdateKeyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	store := kc.App.GetStore()
+	if err := store.EnsureLocalKeys(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	key, err := store.FindKey(address)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Key not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	usage, disabled := key.Usage, key.Disabled
+	if request.Usage != nil {
+		if usage, err = models.ParseKeyUsage(*request.Usage); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	if request.Disabled != nil {
+		disabled = *request.Disabled
+	}
+
+	key, err = store.UpdateKey(address, usage, disabled)
+	if err == strpkg.ErrKeyHasUnconfirmedTxs {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewETHKey(key), "key")
+}
+
+// Delete removes a key from the node
+// Example:
+//  "<application>/keys/delete"
+func (kc *KeysController) Delete(c *gin.Context) {
+	request := models.DeleteKeyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := kc.App.GetStore().RemoveKey(request.Address, request.CurrentPassword)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Key not found"))
+		return
+	} else if err == strpkg.ErrKeyHasUnconfirmedTxs {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err == strpkg.ErrRemoteSignerKeys {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, nil, "key", http.StatusNoContent)
+}
+
+// Rotate replaces a key with a new one, retiring the old key once its
+// pending transactions are confirmed
+// Example:
+//  "<application>/keys/rotate"
+func (kc *KeysController) Rotate(c *gin.Context) {
+	request := models.RotateKeyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	store := kc.App.GetStore()
+	if err := store.KeyStore.Unlock(request.CurrentPassword); err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	key, err := store.RotateKey(request.Address, request.CurrentPassword)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Key not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewETHKey(key), "key", http.StatusCreated)
+}
+
+func (kc *KeysController) address(c *gin.Context) (common.Address, bool) {
+	param := c.Param("address")
+	if !common.IsHexAddress(param) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid address %s", param))
+		return common.Address{}, false
+	}
+	return common.HexToAddress(param), true
+}
@@ -3,12 +3,15 @@ package web_test
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestKeysController_CreateSuccess(t *testing.T) {
@@ -99,3 +102,62 @@ func TestKeysController_JSONBindingError(t *testing.T) {
 
 	ethMock.AllCalled()
 }
+
+func TestKeysController_IndexAndUpdate(t *testing.T) {
+	t.Parallel()
+
+	config, _ := cltest.NewConfig(t)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config)
+	defer cleanup()
+
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_getTransactionCount", "0x100")
+		ethMock.Register("eth_chainId", config.ChainID())
+	})
+
+	client := app.NewHTTPClient()
+	require.NoError(t, app.StartAndConnect())
+	address := cltest.GetAccountAddress(t, app.Store)
+
+	resp, cleanup := client.Get("/v2/keys")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var keys []presenters.ETHKey
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &keys))
+	require.Len(t, keys, 1)
+	assert.Equal(t, address.Hex(), keys[0].Address)
+	assert.Equal(t, models.KeyUsageSendReceive, keys[0].Usage)
+
+	tests := []struct {
+		name       string
+		address    string
+		body       string
+		wantStatus int
+	}{
+		{"send only", address.Hex(), `{"usage":"send_only"}`, http.StatusOK},
+		{"disabled", address.Hex(), `{"disabled":true}`, http.StatusOK},
+		{"invalid usage", address.Hex(), `{"usage":"sometimes"}`, http.StatusUnprocessableEntity},
+		{"invalid address", "0x1", `{"disabled":false}`, http.StatusUnprocessableEntity},
+		{"unknown key", cltest.NewAddress().Hex(), `{"disabled":false}`, http.StatusNotFound},
+	}
+	for _, test := range tests {
+		resp, cleanup := client.Patch("/v2/keys/"+test.address, bytes.NewBufferString(test.body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, test.wantStatus)
+	}
+
+	key, err := app.Store.FindKey(address)
+	require.NoError(t, err)
+	assert.Equal(t, models.KeyUsageSendOnly, key.Usage)
+	assert.True(t, key.Disabled)
+
+	resp, cleanup = client.Get("/v2/keys?receiving=true")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var receiving []presenters.ETHKey
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &receiving))
+	assert.Len(t, receiving, 0)
+
+	ethMock.AllCalled()
+}
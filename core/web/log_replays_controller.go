@@ -0,0 +1,81 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// LogReplaysController scans historical logs again for missed runs
+type LogReplaysController struct {
+	App chainlink.Application
+}
+
+// Create starts replaying the logs of a block range in the background,
+// creating the runs of the log initiated jobs that were missed
+// Example:
+//  "<application>/log_replays"
+func (lrc *LogReplaysController) Create(c *gin.Context) {
+	request := models.LogReplayRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := models.ValidateLogReplayRequest(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	store := lrc.App.GetStore()
+	if _, err := store.Chain(request.ChainID.ToInt()); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.JobID != nil {
+		_, err := store.FindJob(request.JobID)
+		if errors.Cause(err) == orm.ErrorNotFound {
+			jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	replay, err := services.StartLogReplay(store, lrc.App, request)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, &replay, "replay", http.StatusAccepted)
+}
+
+// Show returns the status and counts of a log replay
+// Example:
+//  "<application>/log_replays/:ID"
+func (lrc *LogReplaysController) Show(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid log replay ID"))
+		return
+	}
+
+	replay, err := lrc.App.GetStore().FindLogReplay(id)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("log replay not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, &replay, "replay")
+}
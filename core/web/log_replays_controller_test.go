@@ -0,0 +1,116 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	ethpkg "github.com/smartcontractkit/chainlink/core/eth"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogReplaysController_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	log := cltest.LogFromFixture(t, "../services/testdata/subscription_logs.json")
+	app.EthMock.Register("eth_getLogs", []ethpkg.Log{log})
+
+	body := fmt.Sprintf(`{"fromBlock":1,"toBlock":100,"jobId":"%s"}`, job.ID.String())
+	resp, cleanup := client.Post("/v2/log_replays", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+
+	var replay models.LogReplay
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &replay))
+	assert.NotZero(t, replay.ID)
+	assert.Equal(t, uint64(1), replay.FromBlock)
+	assert.Equal(t, uint64(100), replay.ToBlock)
+
+	cltest.WaitForRuns(t, job, app.Store, 1)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() models.LogReplayStatus {
+		replay, err := app.Store.FindLogReplay(replay.ID)
+		require.NoError(t, err)
+		return replay.Status
+	}).Should(gomega.Equal(models.LogReplayStatusCompleted))
+
+	stored, err := app.Store.FindLogReplay(replay.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stored.LogsFound)
+	assert.Equal(t, 1, stored.LogsReplayed)
+	assert.Equal(t, 0, stored.LogsSkipped)
+}
+
+func TestLogReplaysController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	from, to := uint64(1), uint64(10)
+	replay := models.NewLogReplay(models.LogReplayRequest{FromBlock: &from, ToBlock: &to})
+	require.NoError(t, app.Store.CreateLogReplay(&replay))
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/log_replays/%d", replay.ID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var shown models.LogReplay
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &shown))
+	assert.Equal(t, replay.ID, shown.ID)
+	assert.Equal(t, models.LogReplayStatusInProgress, shown.Status)
+
+	resp, cleanup = client.Get(fmt.Sprintf("/v2/log_replays/%d", replay.ID+1))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Get("/v2/log_replays/abc")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestLogReplaysController_Create_Errors(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"empty range", `{"fromBlock":10,"toBlock":9}`, http.StatusUnprocessableEntity},
+		{"missing fromBlock", `{"toBlock":9}`, http.StatusUnprocessableEntity},
+		{"missing toBlock", `{"fromBlock":1}`, http.StatusUnprocessableEntity},
+		{"unknown chain", `{"fromBlock":1,"toBlock":9,"chainId":"424242"}`, http.StatusUnprocessableEntity},
+		{"unknown job", `{"fromBlock":1,"toBlock":9,"jobId":"` + cltest.NewJob().ID.String() + `"}`, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/log_replays", bytes.NewBufferString(test.body))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, test.wantStatus)
+		})
+	}
+}
@@ -219,6 +219,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/specs", paginatedRequest(j.Index))
 		authv2.GET("/specs/:SpecID", j.Show)
 		authv2.DELETE("/specs/:SpecID", j.Destroy)
+		authv2.PUT("/specs/:SpecID/pause", j.Pause)
+		authv2.PUT("/specs/:SpecID/resume", j.Resume)
+
+		frc := FluxMonitorRoundsController{app}
+		authv2.GET("/specs/:SpecID/flux_rounds", paginatedRequest(frc.Index))
 
 		authv2.GET("/runs", paginatedRequest(jr.Index))
 		authv2.GET("/runs/:RunID", jr.Show)
@@ -239,10 +244,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		ts := TransfersController{app}
 		authv2.POST("/transfers", ts.Create)
 
+		kc := KeysController{app}
 		if app.GetStore().Config.Dev() {
-			kc := KeysController{app}
 			authv2.POST("/keys", kc.Create)
 		}
+		authv2.GET("/keys", kc.Index)
+		authv2.POST("/keys/import", kc.Import)
+		authv2.POST("/keys/export", kc.Export)
+		authv2.POST("/keys/rotate", kc.Rotate)
+		authv2.POST("/keys/delete", kc.Delete)
+		authv2.PATCH("/keys/:address", kc.Update)
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
@@ -255,8 +266,21 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
+		chc := ChainsController{app}
+		authv2.GET("/chains", chc.Index)
+		authv2.POST("/chains", chc.Create)
+		authv2.DELETE("/chains/:ChainID", chc.Destroy)
+		authv2.GET("/chains/:ChainID/transactions", paginatedRequest(chc.Transactions))
+
 		bdc := BulkDeletesController{app}
 		authv2.DELETE("/bulk_delete_runs", bdc.Delete)
+
+		lrc := LogReplaysController{app}
+		authv2.POST("/log_replays", lrc.Create)
+		authv2.GET("/log_replays/:ID", lrc.Show)
+
+		vpc := VRFProofsController{app}
+		authv2.POST("/vrf/proofs", rateLimiter(1*time.Minute, 60), vpc.Create)
 	}
 
 	ping := PingController{app}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -106,19 +107,27 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 	}
 }
 
-// AccountBalances returns the account balances of ETH & LINK.
+// AccountBalances returns the account balances of ETH & LINK on every chain
+// served by the node, as last seen by the balance monitor if it is running.
+// Balances on the default chain come first.
 // Example:
 //  "<application>/user/balances"
 func (c *UserController) AccountBalances(ctx *gin.Context) {
 	store := c.App.GetStore()
 	accounts := store.KeyStore.Accounts()
 	balances := []presenters.AccountBalance{}
-	for _, a := range accounts {
-		pa := getAccountBalanceFor(ctx, store, a)
-		if ctx.IsAborted() {
-			return
+	for i, chain := range store.Chains() {
+		monitor := c.App.GetBalanceMonitor(chain.ID)
+		for _, a := range accounts {
+			pa := getAccountBalanceFor(ctx, chain.TxManager, monitor, a)
+			if ctx.IsAborted() {
+				return
+			}
+			if i > 0 {
+				pa.ChainID = chain.ID.String()
+			}
+			balances = append(balances, pa)
 		}
-		balances = append(balances, pa)
 	}
 
 	jsonAPIResponse(ctx, balances, "balances")
@@ -156,8 +165,19 @@ func (c *UserController) updateUserPassword(ctx *gin.Context, user *models.User,
 	return nil
 }
 
-func getAccountBalanceFor(ctx *gin.Context, store *store.Store, account accounts.Account) presenters.AccountBalance {
-	txm := store.TxManager
+func getAccountBalanceFor(ctx *gin.Context, txm store.TxManager, monitor services.BalanceMonitor, account accounts.Account) presenters.AccountBalance {
+	if monitor != nil {
+		ethBalance := monitor.EthBalance(account.Address)
+		linkBalance := monitor.LinkBalance(account.Address)
+		if ethBalance != nil && linkBalance != nil {
+			return presenters.AccountBalance{
+				Address:     account.Address.Hex(),
+				EthBalance:  ethBalance,
+				LinkBalance: linkBalance,
+			}
+		}
+	}
+
 	ethBalance, err := txm.GetEthBalance(account.Address)
 	if err != nil {
 		err = fmt.Errorf("error calling getEthBalance on Ethereum node: %v", err)
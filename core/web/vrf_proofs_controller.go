@@ -0,0 +1,100 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// VRFProofsController generates VRF proofs for seeds supplied off-chain
+type VRFProofsController struct {
+	App chainlink.Application
+}
+
+// VRFProofResponse is a VRF proof, along with the random output it proves.
+// The proof is for ProofSeed, the off-chain domain separated Seed.
+type VRFProofResponse struct {
+	PublicKey string      `json:"publicKey"`
+	KeyHash   common.Hash `json:"keyHash"`
+	Seed      *utils.Big  `json:"seed"`
+	ProofSeed *utils.Big  `json:"proofSeed"`
+	Output    *utils.Big  `json:"output"`
+	Proof     string      `json:"proof"`
+}
+
+// GetID returns the jsonapi ID.
+func (VRFProofResponse) GetID() string {
+	return "proof"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*VRFProofResponse) SetID(string) error {
+	return nil
+}
+
+// Create generates the proof of the VRF output for the given seed, under the
+// given public key, which must be unlocked on the node. The seed is hashed
+// into the off-chain domain first, so the proof can not fulfil an on-chain
+// request made to the same key.
+// The proof is the input expected by VRF.sol#randomValueFromVRFProof.
+// Example:
+//  "<application>/vrf/proofs"
+func (vpc *VRFProofsController) Create(c *gin.Context) {
+	request := models.VRFProofRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := models.ValidateVRFProofRequest(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	keyHash, err := request.PublicKey.Hash()
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	proofSeed := vrf.OffChainSeed(request.Seed.ToInt())
+	proof, err := vpc.App.GetStore().VRFKeyStore.GenerateProof(&request.PublicKey, proofSeed)
+	if errors.Cause(err) == store.ErrVRFKeyNotUnlocked {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	goProof, err := vrf.UnmarshalSolidityProof(proof[:])
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	logger.Infow("Generated VRF proof for off-chain request",
+		"publicKey", request.PublicKey.String(),
+		"keyHash", keyHash.Hex(),
+		"seed", request.Seed.String(),
+		"proofSeed", proofSeed.String(),
+		"output", goProof.Output.String(),
+	)
+
+	jsonAPIResponse(c, &VRFProofResponse{
+		PublicKey: request.PublicKey.String(),
+		KeyHash:   keyHash,
+		Seed:      request.Seed,
+		ProofSeed: utils.NewBig(proofSeed),
+		Output:    utils.NewBig(goProof.Output),
+		Proof:     proof.String(),
+	}, "proof")
+}
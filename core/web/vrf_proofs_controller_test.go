@@ -0,0 +1,100 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/web"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVRFProofsController_Create(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+	publicKey := cltest.StoredVRFKey(t, app.Store)
+
+	body := fmt.Sprintf(`{"publicKey":"%s","seed":"0x10"}`, publicKey.String())
+	resp, cleanup := client.Post("/v2/vrf/proofs", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var proof web.VRFProofResponse
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &proof))
+	assert.Equal(t, publicKey.String(), proof.PublicKey)
+	assert.Equal(t, publicKey.MustHash(), proof.KeyHash)
+	assert.Equal(t, "16", proof.Seed.String())
+	assert.Equal(t, vrf.OffChainSeed(big.NewInt(16)), proof.ProofSeed.ToInt())
+	assert.NotEqual(t,
+		common.HexToHash("c0a5642a409290ac65d9d44a4c52e53f31921ff1b7d235c585193a18190c82f1"),
+		common.BigToHash(proof.Output.ToInt()),
+		"should not be the output the random adapter proves for the same key and seed")
+
+	goProof, err := vrf.UnmarshalSolidityProof(hexutil.MustDecode(proof.Proof))
+	require.NoError(t, err)
+	assert.Equal(t, proof.Output.ToInt(), goProof.Output)
+	assert.Equal(t, proof.ProofSeed.ToInt(), goProof.Seed)
+}
+
+func TestVRFProofsController_Create_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+	publicKey := cltest.StoredVRFKey(t, app.Store)
+
+	body := fmt.Sprintf(`{"publicKey":"%s","seed":"0x10"}`, publicKey.String())
+	for i := 0; i < 60; i++ {
+		resp, cleanup := client.Post("/v2/vrf/proofs", bytes.NewBufferString(body))
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		cleanup()
+	}
+
+	resp, cleanup := client.Post("/v2/vrf/proofs", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusTooManyRequests)
+}
+
+func TestVRFProofsController_Create_Errors(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+	publicKey := cltest.StoredVRFKey(t, app.Store)
+	lockedKey := "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"malformed key", `{"publicKey":"0xdeadbeef","seed":"1"}`, http.StatusBadRequest},
+		{"missing seed", `{"publicKey":"` + publicKey.String() + `"}`, http.StatusUnprocessableEntity},
+		{"seed out of range", `{"publicKey":"` + publicKey.String() + `","seed":"-1"}`, http.StatusUnprocessableEntity},
+		{"locked key", `{"publicKey":"` + lockedKey + `","seed":"1"}`, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/vrf/proofs", bytes.NewBufferString(test.body))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, test.wantStatus)
+		})
+	}
+}
@@ -46,6 +46,7 @@ func TestWithdrawalsController_CreateSuccess(t *testing.T) {
 	txManager.On("SubscribeToNewHeads", mock.Anything, mock.Anything).Maybe().Return(subscription, nil)
 	txManager.On("GetChainID").Maybe().Return(big.NewInt(3), nil)
 	txManager.On("Register", mock.Anything).Return(big.NewInt(3), nil)
+	txManager.On("Retire", mock.Anything)
 	txManager.On("ContractLINKBalance", wr).Return(*wr.Amount, nil)
 	txManager.On("WithdrawLINK", wr).Return(cltest.NewHash(), nil)
 	app.Store.TxManager = txManager
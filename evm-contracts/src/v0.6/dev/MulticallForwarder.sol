@@ -0,0 +1,51 @@
+pragma solidity ^0.6.0;
+
+import "../Owned.sol";
+
+/**
+ * @title The MulticallForwarder contract
+ * @notice Relays a batch of calls to a single target contract, so that a
+ * node can send several oracle fulfillments in one transaction.
+ * @dev The forwarder must be an authorized node of the target Oracle. Each
+ * call is made independently: a reverting call does not revert the batch,
+ * and its outcome is reported by a CallResult event.
+ */
+contract MulticallForwarder is Owned {
+
+  mapping(address => bool) public authorizedSenders;
+
+  event CallResult(
+    uint256 indexed index,
+    bool success
+  );
+
+  /**
+   * @notice Allows or disallows an address to send batches through the
+   * forwarder.
+   * @param _sender The address of the node's account
+   * @param _allowed Whether the sender is authorized
+   */
+  function setAuthorizedSender(address _sender, bool _allowed)
+    external
+    onlyOwner()
+  {
+    authorizedSenders[_sender] = _allowed;
+  }
+
+  /**
+   * @notice Calls _target with each of the given call data, in order.
+   * @param _target The contract to call, usually an Oracle
+   * @param _calls The data of each call
+   */
+  function forward(address _target, bytes[] calldata _calls)
+    external
+  {
+    require(authorizedSenders[msg.sender], "Not an authorized sender");
+    for (uint256 i = 0; i < _calls.length; i++) {
+      // solhint-disable-next-line avoid-low-level-calls
+      (bool success, ) = _target.call(_calls[i]);
+      emit CallResult(i, success);
+    }
+  }
+
+}